@@ -0,0 +1,26 @@
+package allfuncs
+
+import (
+	"testing"
+
+	"github.com/rmadar/tree-gonalyzer/internal/fastfuncs"
+	"github.com/rmadar/tree-gonalyzer/internal/fastfuncs/fastfuncstest"
+	"github.com/rmadar/tree-gonalyzer/internal/genfuncs"
+)
+
+// Test that the fast formulas of all function types, registered
+// by default or by this package, give the same results as the
+// generic formula.
+func TestFastFormulas(t *testing.T) {
+	if got, want := len(fastfuncs.Funcs), len(genfuncs.All()); got != want {
+		t.Skipf("%d function types out of %d: funcs_gen.go is not generated", got, want)
+	}
+	for typ, f := range fastfuncs.Funcs {
+		bind := func(rvars []string, fct interface{}, ptrs []interface{}) (interface{}, error) {
+			return f.Bind(fct, ptrs), nil
+		}
+		if err := fastfuncstest.Check(typ, bind); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+// Package allfuncs registers the fast formulas of all the TreeFunc
+// signatures which are not registered by default, ie of all the
+// functions taking up to four arguments of type bool, int32, int64,
+// float32, float64 or their slices, and returning a bool, a float64
+// or a []float64. It is imported for this side effect only:
+//
+//	import _ "github.com/rmadar/tree-gonalyzer/ana/allfuncs"
+//
+// TreeFunc's with such functions are otherwise evaluated with
+// reflect, which is slower but gives the same results.
+//
+// The generated code is large (~33k functions, ~13MB), and is not
+// committed: it is written in funcs_gen.go by 'go generate ./ana',
+// eg in a local copy of the module used with a replace directive.
+// Without it, importing the package has no effect. With it, the
+// package takes one to three minutes to compile the first time,
+// and adds ~60MB to the binaries importing it.
+package allfuncs
@@ -0,0 +1,628 @@
+package ana
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"go-hep.org/x/hep/hbook"
+)
+
+// cacheDir is the directory of the histogram cache, in SavePath.
+const cacheDir = ".cache"
+
+// cacheFile is the content of the cache of a component: its
+// number of events, its histograms and its yields, indexed by
+// the key of their definition. Profiles are stored as three
+// 1D histograms.
+type cacheFile struct {
+	NEvts  int64
+	H1D    map[string]*hbook.H1D
+	H2D    map[string]*hbook.H2D
+	Yields map[string]yieldCount
+}
+
+// compCache is the cache of a component, with the keys of all
+// its histograms. An empty key stands for a histogram which
+// cannot be cached.
+type compCache struct {
+	path string          // Path of the cache file.
+	file cacheFile       // Cached histograms.
+	mask fillMask        // Histograms to fill, not being cached.
+	h    [][]string      // Keys of histograms h[iCut][iVar].
+	hs   [][2][][]string // Keys of varied histograms hs[iSyst][up/down][iCut][iVar].
+	h2   [][]string      // Keys of 2D histograms h2[iCut][iVar2D].
+	hp   [][]string      // Keys of profiles hp[iCut][iProf].
+	y    []string        // Keys of yields y[iCut].
+	cf   []string        // Keys of cut-flow counts cf[iStep].
+}
+
+// fillMask tells which variables, 2D variables and profiles
+// are filled by the event loops, and if the yields and the cut
+// flow are counted.
+// A nil mask fills all of them.
+type fillMask struct {
+	vars, vars2D, profs []bool
+	yields              bool
+}
+
+// Helper function returning true if the variable is filled.
+func (m *fillMask) variable(iv int) bool {
+	return m == nil || m.vars[iv]
+}
+
+// Helper function returning true if the 2D variable is filled.
+func (m *fillMask) variable2D(iv int) bool {
+	return m == nil || m.vars2D[iv]
+}
+
+// Helper function returning true if the profile is filled.
+func (m *fillMask) profile(ip int) bool {
+	return m == nil || m.profs[ip]
+}
+
+// Helper function returning true if anything is filled.
+func (m *fillMask) any() bool {
+	if m.yields {
+		return true
+	}
+	for _, bs := range [][]bool{m.vars, m.vars2D, m.profs} {
+		for _, b := range bs {
+			if b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Helper function returning true if the cache is used.
+func (ana *Maker) useCache() bool {
+	return ana.Cache && ana.PlotHisto && !ana.DumpTree
+}
+
+// Helper function returning the mask of the histograms
+// to fill for a component.
+func (ana *Maker) fillMaskOf(is, ic int) *fillMask {
+	if ana.cache == nil || ana.cache[is][ic] == nil {
+		return nil
+	}
+	return &ana.cache[is][ic].mask
+}
+
+// Helper function returning the cache of all components,
+// with the histograms to fill. The cache of a component
+// is nil if it cannot be cached.
+func (ana *Maker) newCaches() [][]*compCache {
+
+	ids := newFuncIdentifier()
+
+	// Definitions of the histograms, common to all components
+	sels := make([]string, len(ana.KinemCuts))
+	for i, sel := range ana.KinemCuts {
+		sels[i] = ids.identity(&sel.TreeFunc)
+	}
+	steps := []string{"cutflow"}
+	for _, cut := range ana.CutFlowCuts {
+		id := ids.identity(&cut.TreeFunc)
+		if id == "" || steps[len(steps)-1] == "" {
+			steps = append(steps, "")
+			continue
+		}
+		steps = append(steps, steps[len(steps)-1]+"|"+id)
+	}
+	vars := make([]string, len(ana.Variables))
+	for i, v := range ana.Variables {
+		if id := ids.identity(&v.TreeFunc); id != "" {
+			vars[i] = fmt.Sprint(id, v.Nbins, v.Xmin, v.Xmax, v.BinEdges)
+		}
+	}
+	vars2D := make([]string, len(ana.Variables2D))
+	for i, v := range ana.Variables2D {
+		x, y := ids.identity(&v.XTreeFunc), ids.identity(&v.YTreeFunc)
+		if x != "" && y != "" {
+			vars2D[i] = fmt.Sprint(x, y, v.Nx, v.Xmin, v.Xmax, v.Ny, v.Ymin, v.Ymax)
+		}
+	}
+	profs := make([]string, len(ana.Profiles))
+	for i, p := range ana.Profiles {
+		x, y := ids.identity(&p.XTreeFunc), ids.identity(&p.YTreeFunc)
+		if x != "" && y != "" {
+			profs[i] = fmt.Sprint(x, y, p.Nbins, p.Xmin, p.Xmax)
+		}
+	}
+
+	caches := make([][]*compCache, len(ana.Samples))
+	for is, samp := range ana.Samples {
+		caches[is] = make([]*compCache, len(samp.components))
+		for ic, comp := range samp.components {
+			key := ana.componentKey(ids, samp, comp)
+			if key == "" {
+				continue
+			}
+			cc := &compCache{path: filepath.Join(ana.SavePath, cacheDir, key+".gob")}
+			cc.file = readCacheFile(cc.path)
+			cc.mask = fillMask{
+				vars:   make([]bool, len(vars)),
+				vars2D: make([]bool, len(vars2D)),
+				profs:  make([]bool, len(profs)),
+			}
+
+			// Keys of all histograms, and the ones to fill.
+			need := func(key string) bool {
+				return key == "" || cc.file.H1D[key] == nil && cc.file.H2D[key] == nil
+			}
+			cc.h = histKeys(sels, vars)
+			cc.h2 = histKeys(sels, vars2D)
+			cc.hp = histKeys(sels, profs)
+			cc.y = make([]string, len(sels))
+			for ic, sel := range sels {
+				if sel != "" {
+					cc.y[ic] = hashKey(sel, "yields")
+				}
+			}
+			cc.cf = make([]string, len(steps))
+			for k, step := range steps {
+				if step != "" {
+					cc.cf[k] = hashKey(step)
+				}
+			}
+			for _, key := range append(append([]string{}, cc.y...), cc.cf...) {
+				_, ok := cc.file.Yields[key]
+				cc.mask.yields = cc.mask.yields || !ok
+			}
+			cc.hs = make([][2][][]string, len(ana.systNames))
+			for k, name := range ana.systNames {
+				if !samp.hasSystematic(name) {
+					continue
+				}
+				defs := vars
+				syst, ok := systematicKey(ids, samp, comp, name)
+				if !ok {
+					defs = make([]string, len(vars))
+				}
+				for i, dir := range []string{systUpSuffix, systDownSuffix} {
+					cc.hs[k][i] = histKeys(sels, defs, name, dir, syst)
+				}
+			}
+			for ic := range sels {
+				for iv := range vars {
+					cc.mask.vars[iv] = cc.mask.vars[iv] || need(cc.h[ic][iv])
+					for _, keys := range cc.hs {
+						for _, ks := range keys {
+							if ks != nil {
+								cc.mask.vars[iv] = cc.mask.vars[iv] || need(ks[ic][iv])
+							}
+						}
+					}
+				}
+				for iv := range vars2D {
+					cc.mask.vars2D[iv] = cc.mask.vars2D[iv] || need(cc.h2[ic][iv])
+				}
+				for ip := range profs {
+					key := cc.hp[ic][ip]
+					cc.mask.profs[ip] = cc.mask.profs[ip] || key == "" || need(key+"/w")
+				}
+			}
+			caches[is][ic] = cc
+		}
+	}
+
+	return caches
+}
+
+// Helper function returning the key of the inputs of a component,
+// from its files, its normalization, and the cuts and weights of
+// its sample. It is empty if the component cannot be cached.
+func (ana *Maker) componentKey(ids *funcIdentifier, samp *Sample, comp *sampleComponent) string {
+	parts := []string{fmt.Sprint(ana.NevtsMax, ana.Lumi, samp.sType, comp.Xsec, comp.Ngen)}
+	inputs := append([]input{{FileName: comp.FileName, TreeName: comp.TreeName}}, comp.JointTrees...)
+	for _, in := range inputs {
+		path, err := filepath.Abs(in.FileName)
+		if err != nil {
+			return ""
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return ""
+		}
+		parts = append(parts, path, in.TreeName, fmt.Sprint(info.Size(), info.ModTime().UnixNano()))
+	}
+	for _, f := range []*TreeFunc{&samp.WeightFunc, &samp.CutFunc, &comp.WeightFunc, &comp.CutFunc} {
+		id := ids.identity(f)
+		if id == "" {
+			return ""
+		}
+		parts = append(parts, id)
+	}
+	return hashKey(parts...)
+}
+
+// Helper function returning the definition of the systematic
+// variation of a component, as declared for its sample and
+// for itself, and false if it cannot be cached.
+func systematicKey(ids *funcIdentifier, samp *Sample, comp *sampleComponent, name string) (string, bool) {
+	key := ""
+	for _, syst := range append(append([]systematic{}, samp.systs...), comp.systs...) {
+		if syst.Name != name {
+			continue
+		}
+		up, down := "", ""
+		switch syst.Kind {
+		case weightSyst:
+			up, down = ids.identity(&syst.Up), ids.identity(&syst.Down)
+			if up == "" || down == "" {
+				return "", false
+			}
+		case treeSyst:
+			up, down = syst.UpTree, syst.DownTree
+		case branchSyst:
+			up, down = sortedMap(syst.UpBranches), sortedMap(syst.DownBranches)
+		}
+		key += fmt.Sprintf("%d|%s|%s|", syst.Kind, up, down)
+	}
+	return key, true
+}
+
+// Helper function returning the content of a map, sorted by keys.
+func sortedMap(m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	s := ""
+	for _, k := range keys {
+		s += fmt.Sprintf("%q:%q,", k, m[k])
+	}
+	return s
+}
+
+// Helper function returning the keys k[iCut][iDef] of the
+// histograms of all selections and definitions. A key is
+// empty if the selection or the definition is.
+func histKeys(sels, defs []string, extra ...string) [][]string {
+	keys := make([][]string, len(sels))
+	for ic, sel := range sels {
+		keys[ic] = make([]string, len(defs))
+		for id, def := range defs {
+			if sel != "" && def != "" {
+				keys[ic][id] = hashKey(append([]string{sel, def}, extra...)...)
+			}
+		}
+	}
+	return keys
+}
+
+// Helper function hashing strings into a key.
+func hashKey(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		io.WriteString(h, p)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+// Helper function returning the result of a component, made of
+// the filled histograms r (possibly nil), completed with the cached
+// ones. The cache is then updated with the filled histograms.
+func (ana *Maker) cachedResult(is, ic int, r *jobResult) (*jobResult, error) {
+
+	samp := ana.Samples[is]
+	cc := ana.cache[is][ic]
+	filled := r != nil
+	if r == nil {
+		res := ana.newJobResult(samp)
+		r = &res
+		if cc != nil && !cc.mask.any() {
+			r.nEvts = cc.file.NEvts
+			ana.nEvtsSample[is] += r.nEvts
+			ana.nEvents += r.nEvts
+		}
+	}
+	if cc == nil {
+		return r, nil
+	}
+
+	// Load the cached histograms, store the filled ones.
+	h1 := func(key string, filled bool, h **hbook.H1D) {
+		switch {
+		case key == "":
+		case filled:
+			cc.file.H1D[key] = *h
+		default:
+			*h = cc.file.H1D[key]
+		}
+	}
+	for iCut := range ana.KinemCuts {
+		for iv := range ana.Variables {
+			filled := cc.mask.variable(iv)
+			h1(cc.h[iCut][iv], filled, &r.h[iCut][iv])
+			for k, keys := range cc.hs {
+				if keys[0] == nil {
+					continue
+				}
+				h1(keys[0][iCut][iv], filled, &r.hs[k].up[iCut][iv])
+				h1(keys[1][iCut][iv], filled, &r.hs[k].down[iCut][iv])
+			}
+		}
+		for iv := range ana.Variables2D {
+			switch key := cc.h2[iCut][iv]; {
+			case key == "":
+			case cc.mask.variable2D(iv):
+				cc.file.H2D[key] = r.h2[iCut][iv]
+			default:
+				r.h2[iCut][iv] = cc.file.H2D[key]
+			}
+		}
+		for ip := range ana.Profiles {
+			key, filled := cc.hp[iCut][ip], cc.mask.profile(ip)
+			if key == "" {
+				continue
+			}
+			p := &r.hp[iCut][ip]
+			h1(key+"/w", filled, &p.w)
+			h1(key+"/wy", filled, &p.wy)
+			h1(key+"/wy2", filled, &p.wy2)
+		}
+	}
+
+	// Yields and cut flows are counted whenever the
+	// component is read.
+	count := func(key string, y *yieldCount) {
+		switch {
+		case key == "":
+		case filled:
+			cc.file.Yields[key] = *y
+		default:
+			*y = cc.file.Yields[key]
+		}
+	}
+	for iCut, key := range cc.y {
+		count(key, &r.y[iCut])
+	}
+	for k, key := range cc.cf {
+		count(key, &r.cf[k])
+	}
+
+	// Update the cache file.
+	if cc.mask.any() {
+		cc.file.NEvts = r.nEvts
+		if err := writeCacheFile(cc.path, cc.file); err != nil {
+			comp := samp.components[ic]
+			return nil, &Error{Op: "write cache", Sample: samp.Name, File: comp.FileName, Err: err}
+		}
+	}
+
+	return r, nil
+}
+
+// Helper function reading a cache file. An empty cache
+// is returned if the file is missing or cannot be read.
+func readCacheFile(path string) cacheFile {
+	cf := cacheFile{
+		H1D:    make(map[string]*hbook.H1D),
+		H2D:    make(map[string]*hbook.H2D),
+		Yields: make(map[string]yieldCount),
+	}
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cf
+	}
+	var dec cacheFile
+	if err := gob.NewDecoder(bytes.NewReader(raw)).Decode(&dec); err != nil {
+		return cf
+	}
+	if dec.H1D == nil {
+		dec.H1D = cf.H1D
+	}
+	if dec.H2D == nil {
+		dec.H2D = cf.H2D
+	}
+	if dec.Yields == nil {
+		dec.Yields = cf.Yields
+	}
+	return dec
+}
+
+// Helper function writing a cache file, replacing the
+// previous one only once fully written.
+func writeCacheFile(path string, cf cacheFile) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(cf); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// funcIdentifier gives the identity of the TreeFunc's, from the
+// source code of their function, parsing each file only once.
+type funcIdentifier struct {
+	fset  *token.FileSet
+	files map[string]*srcFile
+}
+
+// srcFile is a parsed source file.
+type srcFile struct {
+	src  []byte
+	file *ast.File
+}
+
+func newFuncIdentifier() *funcIdentifier {
+	return &funcIdentifier{fset: token.NewFileSet(), files: make(map[string]*srcFile)}
+}
+
+// Helper function returning the identity of a TreeFunc, from its
+// branches and the source code of its function. It is empty if
+// the source code cannot be found.
+func (ids *funcIdentifier) identity(f *TreeFunc) string {
+	switch {
+	case f.id != "":
+		return fmt.Sprintf("%q %s", f.VarsName, f.id)
+	case f.Formula != nil:
+		return ""
+	case f.Fct == nil:
+		return "nil"
+	}
+	src := ids.source(f.Fct)
+	if src == "" {
+		return ""
+	}
+	return fmt.Sprintf("%q %s", f.VarsName, src)
+}
+
+// Helper function returning the source code of a function, or an
+// empty string if it cannot be found unambiguously, or if it doesn't
+// identify the function, ie if it depends on identifiers declared
+// outside of it.
+func (ids *funcIdentifier) source(fct interface{}) string {
+	v := reflect.ValueOf(fct)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+	fn := runtime.FuncForPC(v.Pointer())
+	if fn == nil {
+		return ""
+	}
+	fname, line := fn.FileLine(fn.Entry())
+
+	sf, ok := ids.files[fname]
+	if !ok {
+		sf = &srcFile{}
+		if src, err := ioutil.ReadFile(fname); err == nil {
+			if file, err := parser.ParseFile(ids.fset, fname, src, 0); err == nil {
+				sf.src, sf.file = src, file
+			}
+		}
+		ids.files[fname] = sf
+	}
+	if sf.file == nil {
+		return ""
+	}
+
+	// Functions starting at the line of the entry point.
+	found := []ast.Node{}
+	ast.Inspect(sf.file, func(n ast.Node) bool {
+		switch n.(type) {
+		case *ast.FuncLit, *ast.FuncDecl:
+			if ids.fset.Position(n.Pos()).Line == line {
+				found = append(found, n)
+			}
+		}
+		return true
+	})
+	if len(found) != 1 || hasFreeIdents(sf.file, found[0]) {
+		return ""
+	}
+	beg, end := ids.fset.Position(found[0].Pos()).Offset, ids.fset.Position(found[0].End()).Offset
+	return string(sf.src[beg:end])
+}
+
+// Helper function returning true if the function fn of the file
+// uses identifiers declared outside of it, other than predeclared
+// ones and standard-library packages, eg captured variables,
+// functions of its package or identifiers of other packages, whose
+// value is not given by the source of fn.
+func hasFreeIdents(file *ast.File, fn ast.Node) bool {
+
+	// Name of the imported standard-library packages, assuming
+	// they are the last element of the path. The packages of the
+	// user or of a module may change without changing fn: their
+	// identifiers are free.
+	imports := make(map[string]bool)
+	for _, imp := range file.Imports {
+		path := strings.Trim(imp.Path.Value, "`\"")
+		if !isStdPkg(path) {
+			continue
+		}
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = true
+	}
+
+	// Identifiers which don't refer to a declared object.
+	skip := make(map[*ast.Ident]bool)
+
+	free := false
+	ast.Inspect(fn, func(n ast.Node) bool {
+		if free {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			skip[n.Sel] = true
+			if x, ok := n.X.(*ast.Ident); ok && x.Obj == nil && imports[x.Name] {
+				skip[x] = true
+			}
+		case *ast.KeyValueExpr:
+			// Struct fields are not resolved.
+			if k, ok := n.Key.(*ast.Ident); ok && k.Obj == nil {
+				skip[k] = true
+			}
+		case *ast.Ident:
+			switch {
+			case skip[n] || n.Name == "_":
+			case n.Obj == nil:
+				// Predeclared, or declared in another file.
+				free = types.Universe.Lookup(n.Name) == nil
+			default:
+				pos := objPos(n.Obj)
+				free = !pos.IsValid() || pos < fn.Pos() || pos >= fn.End()
+			}
+		}
+		return true
+	})
+	return free
+}
+
+// Standard-library packages found by isStdPkg, by import path.
+var stdPkgs sync.Map
+
+// Helper function returning true if the import path is the one of
+// a standard-library package, ie a package found in GOROOT. Import
+// paths which cannot be found, eg if GOROOT is not installed, are
+// not considered as standard ones.
+func isStdPkg(path string) bool {
+	if std, ok := stdPkgs.Load(path); ok {
+		return std.(bool)
+	}
+	pkg, err := build.Import(path, "", build.FindOnly)
+	std := err == nil && pkg.Goroot
+	stdPkgs.Store(path, std)
+	return std
+}
+
+// Helper function returning the position of the
+// declaration of an object.
+func objPos(obj *ast.Object) token.Pos {
+	if n, ok := obj.Decl.(ast.Node); ok {
+		return n.Pos()
+	}
+	return token.NoPos
+}
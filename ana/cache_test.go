@@ -0,0 +1,58 @@
+package ana
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// Test that TreeFunc's are identified by the source code of their
+// function only if it doesn't depend on identifiers declared outside
+// of it, other than the ones of the standard library.
+func TestFuncIdentity(t *testing.T) {
+
+	k := 2.0
+
+	for _, tc := range []struct {
+		name string
+		fct  interface{}
+		want bool
+	}{
+		{"local", func(x float64) float64 { y := 2 * x; return y }, true},
+		{"std func", func(x float64) float64 { return math.Sqrt(x) }, true},
+		{"std const", func(x float64) float64 { return x * math.Pi }, true},
+		{"captured", func(x float64) float64 { return k * x }, false},
+		{"package func", func(x float64) float64 { return toF64Helper(x) }, false},
+		{"import var", func(x float64) float64 { return x * float64(plotter.DefaultLineStyle.Width) }, false},
+		{"import func", func(x float64) float64 { return float64(vg.Points(x)) }, false},
+	} {
+		ids := newFuncIdentifier()
+		f := TreeFunc{VarsName: []string{"x"}, Fct: tc.fct}
+		if got := ids.identity(&f) != ""; got != tc.want {
+			t.Errorf("%s: identified=%v, want=%v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func toF64Helper(x float64) float64 { return x }
+
+func TestIsStdPkg(t *testing.T) {
+	for _, tc := range []struct {
+		path string
+		want bool
+	}{
+		{"math", true},
+		{"go/ast", true},
+		{"net/http/httptest", true},
+		{"mymod/helpers", false},
+		{"analysis", false},
+		{"gonum.org/v1/plot", false},
+		{"github.com/rmadar/tree-gonalyzer/ana", false},
+	} {
+		if got := isStdPkg(tc.path); got != tc.want {
+			t.Errorf("%s: got=%v, want=%v", tc.path, got, tc.want)
+		}
+	}
+}
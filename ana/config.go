@@ -0,0 +1,695 @@
+package ana
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/plot/vg"
+	"gopkg.in/yaml.v3"
+)
+
+// analysisConfig is the content of a configuration file. Pointer
+// fields are optional: when absent, the default of the Maker is kept.
+type analysisConfig struct {
+	SavePath       string            `yaml:"savePath"`
+	SaveFormat     string            `yaml:"saveFormat"`
+	SaveHistos     string            `yaml:"saveHistos"`
+	PlotTitle      *string           `yaml:"plotTitle"`
+	Lumi           *float64          `yaml:"lumi"`
+	NevtsMax       *int64            `yaml:"nevtsMax"`
+	NWorkers       *int              `yaml:"nWorkers"`
+	SampleMT       *bool             `yaml:"sampleMT"`
+	HistoStack     *bool             `yaml:"histoStack"`
+	SignalStack    *bool             `yaml:"signalStack"`
+	HistoNorm      *bool             `yaml:"histoNorm"`
+	RatioPlot      *bool             `yaml:"ratioPlot"`
+	TotalBand      *bool             `yaml:"totalBand"`
+	TotalBandColor string            `yaml:"totalBandColor"`
+	CompileLatex   *bool             `yaml:"compileLatex"`
+	AutoStyle      *bool             `yaml:"autoStyle"`
+	PlotHisto      *bool             `yaml:"plotHisto"`
+	DumpTree       *bool             `yaml:"dumpTree"`
+	SaveYields     *bool             `yaml:"saveYields"`
+	Cache          *bool             `yaml:"cache"`
+	FlowMode       string            `yaml:"flowMode"`
+	Samples        []sampleConfig    `yaml:"samples"`
+	Variables      []variableConfig  `yaml:"variables"`
+	Selections     []selectionConfig `yaml:"selections"`
+	CutFlow        []selectionConfig `yaml:"cutFlow"`
+}
+
+// sampleConfig describes a sample. The fields file, tree, xsec,
+// ngen and jointTrees define a single-component sample, otherwise
+// the components are listed.
+type sampleConfig struct {
+	Name        string            `yaml:"name"`
+	Type        string            `yaml:"type"`
+	Legend      string            `yaml:"legend"`
+	Weight      *funcConfig       `yaml:"weight"`
+	Cut         *funcConfig       `yaml:"cut"`
+	Style       styleConfig       `yaml:"style"`
+	Systematics []systConfig      `yaml:"systematics"`
+	File        string            `yaml:"file"`
+	Tree        string            `yaml:"tree"`
+	Xsec        *float64          `yaml:"xsec"`
+	Ngen        *float64          `yaml:"ngen"`
+	JointTrees  []treeConfig      `yaml:"jointTrees"`
+	Components  []componentConfig `yaml:"components"`
+}
+
+// componentConfig describes a sample component.
+type componentConfig struct {
+	File        string       `yaml:"file"`
+	Tree        string       `yaml:"tree"`
+	Xsec        *float64     `yaml:"xsec"`
+	Ngen        *float64     `yaml:"ngen"`
+	Weight      *funcConfig  `yaml:"weight"`
+	Cut         *funcConfig  `yaml:"cut"`
+	JointTrees  []treeConfig `yaml:"jointTrees"`
+	Systematics []systConfig `yaml:"systematics"`
+}
+
+// treeConfig describes a joint tree.
+type treeConfig struct {
+	File string `yaml:"file"`
+	Tree string `yaml:"tree"`
+}
+
+// systConfig describes a systematic variation, defined
+// either by weights, by trees or by branches.
+type systConfig struct {
+	Name         string            `yaml:"name"`
+	Up           *funcConfig       `yaml:"up"`
+	Down         *funcConfig       `yaml:"down"`
+	UpTree       string            `yaml:"upTree"`
+	DownTree     string            `yaml:"downTree"`
+	UpBranches   map[string]string `yaml:"upBranches"`
+	DownBranches map[string]string `yaml:"downBranches"`
+}
+
+// styleConfig describes the style of a sample. Colors are
+// given as '#rrggbb' or '#rrggbbaa', lengths in points.
+type styleConfig struct {
+	LineColor     string    `yaml:"lineColor"`
+	LineWidth     *float64  `yaml:"lineWidth"`
+	LineDashes    []float64 `yaml:"lineDashes"`
+	FillColor     string    `yaml:"fillColor"`
+	CircleMarkers *bool     `yaml:"circleMarkers"`
+	CircleSize    *float64  `yaml:"circleSize"`
+	CircleColor   string    `yaml:"circleColor"`
+	Band          *bool     `yaml:"band"`
+	BandColor     string    `yaml:"bandColor"`
+	YErrBars      *bool     `yaml:"yErrBars"`
+	DataStyle     *bool     `yaml:"dataStyle"`
+}
+
+// variableConfig describes a variable.
+type variableConfig struct {
+	Name        string     `yaml:"name"`
+	Func        funcConfig `yaml:"func"`
+	Nbins       int        `yaml:"nbins"`
+	Xmin        float64    `yaml:"xmin"`
+	Xmax        float64    `yaml:"xmax"`
+	BinEdges    []float64  `yaml:"binEdges"`
+	SaveName    string     `yaml:"saveName"`
+	XLabel      string     `yaml:"xLabel"`
+	YLabel      string     `yaml:"yLabel"`
+	XTickFormat string     `yaml:"xTickFormat"`
+	YTickFormat string     `yaml:"yTickFormat"`
+	LogY        *bool      `yaml:"logY"`
+	XRange      []float64  `yaml:"xRange"`
+	YRange      []float64  `yaml:"yRange"`
+	RatioYRange []float64  `yaml:"ratioYRange"`
+	LegLeft     *bool      `yaml:"legLeft"`
+	LegTop      *bool      `yaml:"legTop"`
+	FlowMode    string     `yaml:"flowMode"`
+}
+
+// selectionConfig describes a selection. A selection
+// without cut is the empty selection.
+type selectionConfig struct {
+	Name string      `yaml:"name"`
+	Cut  *funcConfig `yaml:"cut"`
+}
+
+// funcConfig describes a TreeFunc, using exactly one of:
+//   - branch (and type): a single branch, of type f32, f64, i32,
+//     i64, bool, f32s, f64s, i32s or i64s (default: read from the
+//     tree, as in TreeVar and TreeCut),
+//   - expr: an expression, as in TreeExpr,
+//   - func: a TreeFunc registered with RegisterTreeFunc,
+//   - value: a constant value.
+type funcConfig struct {
+	Branch string   `yaml:"branch"`
+	Type   string   `yaml:"type"`
+	Expr   string   `yaml:"expr"`
+	Func   string   `yaml:"func"`
+	Value  *float64 `yaml:"value"`
+}
+
+// NewFromConfig creates an analysis maker from a YAML or JSON
+// configuration file, describing the samples, the variables,
+// the selections and the analysis settings. The logic is supplied
+// by branch names, by expressions (see TreeExpr) or by TreeFunc's
+// registered with RegisterTreeFunc.
+// Relative file paths are resolved from the directory of the
+// configuration file. The options opts override the settings
+// of the file. An example of configuration file is
+// ana/testdata/analysis.yaml.
+func NewFromConfig(fname string, opts ...Options) (Maker, error) {
+
+	raw, err := ioutil.ReadFile(fname)
+	if err != nil {
+		return Maker{}, &Error{Op: "load config", File: fname, Err: err}
+	}
+
+	cfg, err := parseConfig(bytes.NewReader(raw))
+	if err != nil {
+		return Maker{}, &Error{Op: "load config", File: fname, Err: err}
+	}
+
+	s, v, o, err := cfg.build(fname)
+	if err != nil {
+		return Maker{}, err
+	}
+
+	return New(s, v, append(o, opts...)...)
+}
+
+// Helper function decoding a configuration, rejecting
+// unknown fields. JSON is decoded as YAML.
+func parseConfig(r io.Reader) (*analysisConfig, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	cfg := new(analysisConfig)
+	if err := dec.Decode(cfg); err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("%w: empty file", ErrConfig)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrConfig, err)
+	}
+	return cfg, nil
+}
+
+// Helper function returning the samples, the variables and the
+// options described by the configuration file fname, with relative
+// paths taken from its directory.
+func (cfg *analysisConfig) build(fname string) ([]*Sample, []*Variable, []Options, error) {
+
+	path := func(p string) string {
+		if p == "" || filepath.IsAbs(p) {
+			return p
+		}
+		return filepath.Join(filepath.Dir(fname), p)
+	}
+
+	opts, err := cfg.options(path)
+	if err != nil {
+		return nil, nil, nil, &Error{Op: "load config", File: fname, Err: err}
+	}
+
+	samples := make([]*Sample, len(cfg.Samples))
+	for i := range cfg.Samples {
+		sc := &cfg.Samples[i]
+		s, err := sc.sample(path)
+		if err != nil {
+			var e *Error
+			if errors.As(err, &e) {
+				return nil, nil, nil, err
+			}
+			return nil, nil, nil, &Error{Op: "load config", File: fname, Sample: sc.Name, Err: err}
+		}
+		samples[i] = s
+	}
+
+	variables := make([]*Variable, len(cfg.Variables))
+	for i := range cfg.Variables {
+		vc := &cfg.Variables[i]
+		v, err := vc.variable()
+		if err != nil {
+			var e *Error
+			if errors.As(err, &e) {
+				return nil, nil, nil, err
+			}
+			return nil, nil, nil, &Error{Op: "load config", File: fname, Variable: vc.Name, Err: err}
+		}
+		variables[i] = v
+	}
+
+	if len(cfg.Selections) > 0 {
+		selections, err := newSelections(fname, cfg.Selections)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		opts = append(opts, WithKinemCuts(selections))
+	}
+
+	if len(cfg.CutFlow) > 0 {
+		cuts, err := newSelections(fname, cfg.CutFlow)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		opts = append(opts, WithCutFlow(cuts))
+	}
+
+	return samples, variables, opts, nil
+}
+
+// Helper function returning the selections described in
+// the configuration file fname.
+func newSelections(fname string, scs []selectionConfig) ([]*Selection, error) {
+	selections := make([]*Selection, len(scs))
+	for i, sc := range scs {
+		if sc.Cut == nil {
+			selections[i] = EmptySelection()
+			selections[i].Name = sc.Name
+			continue
+		}
+		f, err := sc.Cut.treeFunc(true)
+		if err != nil {
+			return nil, &Error{Op: "load config", File: fname, Selection: sc.Name, Err: err}
+		}
+		selections[i] = NewSelection(sc.Name, f)
+	}
+	return selections, nil
+}
+
+// Helper function returning the analysis options.
+func (cfg *analysisConfig) options(path func(string) string) ([]Options, error) {
+
+	var opts []Options
+	if cfg.SavePath != "" {
+		opts = append(opts, WithSavePath(path(cfg.SavePath)))
+	}
+	if cfg.SaveFormat != "" {
+		opts = append(opts, WithSaveFormat(cfg.SaveFormat))
+	}
+	if cfg.SaveHistos != "" {
+		opts = append(opts, WithSaveHistos(cfg.SaveHistos))
+	}
+	if cfg.PlotTitle != nil {
+		opts = append(opts, WithPlotTitle(*cfg.PlotTitle))
+	}
+	if cfg.Lumi != nil {
+		opts = append(opts, WithLumi(*cfg.Lumi))
+	}
+	if cfg.NevtsMax != nil {
+		opts = append(opts, WithNevtsMax(*cfg.NevtsMax))
+	}
+	if cfg.NWorkers != nil {
+		opts = append(opts, WithNWorkers(*cfg.NWorkers))
+	}
+
+	flags := []struct {
+		val *bool
+		opt func(bool) Options
+	}{
+		{cfg.SampleMT, WithSampleMT},
+		{cfg.HistoStack, WithHistoStack},
+		{cfg.SignalStack, WithSignalStack},
+		{cfg.HistoNorm, WithHistoNorm},
+		{cfg.RatioPlot, WithRatioPlot},
+		{cfg.TotalBand, WithTotalBand},
+		{cfg.CompileLatex, WithCompileLatex},
+		{cfg.AutoStyle, WithAutoStyle},
+		{cfg.PlotHisto, WithPlotHisto},
+		{cfg.DumpTree, WithDumpTree},
+		{cfg.SaveYields, WithSaveYields},
+		{cfg.Cache, WithCache},
+	}
+	for _, f := range flags {
+		if f.val != nil {
+			opts = append(opts, f.opt(*f.val))
+		}
+	}
+
+	if cfg.TotalBandColor != "" {
+		c, err := parseColor(cfg.TotalBandColor)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithTotalBandColor(c))
+	}
+	if cfg.FlowMode != "" {
+		m, err := parseFlowMode(cfg.FlowMode)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithFlowMode(m))
+	}
+
+	return opts, nil
+}
+
+// Helper function returning the sample described by sc.
+func (sc *sampleConfig) sample(path func(string) string) (*Sample, error) {
+
+	opts, err := sc.Style.options()
+	if err != nil {
+		return nil, err
+	}
+	if sc.Weight != nil {
+		f, err := sc.Weight.treeFunc(false)
+		if err != nil {
+			return nil, fmt.Errorf("weight: %w", err)
+		}
+		opts = append(opts, WithWeight(f))
+	}
+	if sc.Cut != nil {
+		f, err := sc.Cut.treeFunc(true)
+		if err != nil {
+			return nil, fmt.Errorf("cut: %w", err)
+		}
+		opts = append(opts, WithCut(f))
+	}
+	systs, err := systOptions(sc.Systematics)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, systs...)
+
+	// Single-component sample
+	if sc.File != "" {
+		if len(sc.Components) > 0 {
+			return nil, fmt.Errorf("%w: both file and components are given", ErrConfig)
+		}
+		if sc.Xsec != nil {
+			opts = append(opts, WithXsec(*sc.Xsec))
+		}
+		if sc.Ngen != nil {
+			opts = append(opts, WithNgen(*sc.Ngen))
+		}
+		for _, jt := range sc.JointTrees {
+			opts = append(opts, WithJointTree(path(jt.File), jt.Tree))
+		}
+		s := CreateSample(sc.Name, sc.Type, sc.Legend, path(sc.File), sc.Tree, opts...)
+		return s, s.err
+	}
+
+	// Multi-component sample
+	if len(sc.Components) == 0 {
+		return nil, fmt.Errorf("%w: no file nor components", ErrConfig)
+	}
+	if sc.Xsec != nil || sc.Ngen != nil || len(sc.JointTrees) > 0 {
+		return nil, fmt.Errorf("%w: xsec, ngen and jointTrees must be given per component", ErrConfig)
+	}
+	s := NewSample(sc.Name, sc.Type, sc.Legend, opts...)
+	for _, cc := range sc.Components {
+		var copts []SampleOptions
+		if cc.Xsec != nil {
+			copts = append(copts, WithXsec(*cc.Xsec))
+		}
+		if cc.Ngen != nil {
+			copts = append(copts, WithNgen(*cc.Ngen))
+		}
+		if cc.Weight != nil {
+			f, err := cc.Weight.treeFunc(false)
+			if err != nil {
+				return nil, fmt.Errorf("component %q: weight: %w", cc.File, err)
+			}
+			copts = append(copts, WithWeight(f))
+		}
+		if cc.Cut != nil {
+			f, err := cc.Cut.treeFunc(true)
+			if err != nil {
+				return nil, fmt.Errorf("component %q: cut: %w", cc.File, err)
+			}
+			copts = append(copts, WithCut(f))
+		}
+		for _, jt := range cc.JointTrees {
+			copts = append(copts, WithJointTree(path(jt.File), jt.Tree))
+		}
+		systs, err := systOptions(cc.Systematics)
+		if err != nil {
+			return nil, fmt.Errorf("component %q: %w", cc.File, err)
+		}
+		copts = append(copts, systs...)
+		s.AddComponent(path(cc.File), cc.Tree, copts...)
+	}
+
+	return s, s.err
+}
+
+// Helper function returning the options of systematic variations.
+func systOptions(systs []systConfig) ([]SampleOptions, error) {
+	opts := make([]SampleOptions, 0, len(systs))
+	for _, sc := range systs {
+		weight := sc.Up != nil || sc.Down != nil
+		tree := sc.UpTree != "" || sc.DownTree != ""
+		branch := len(sc.UpBranches) > 0 || len(sc.DownBranches) > 0
+		switch {
+		case sc.Name == "":
+			return nil, fmt.Errorf("%w: systematic without name", ErrConfig)
+		case weight && !tree && !branch:
+			if sc.Up == nil || sc.Down == nil {
+				return nil, fmt.Errorf("%w: systematic %q needs up and down", ErrConfig, sc.Name)
+			}
+			up, err := sc.Up.treeFunc(false)
+			if err != nil {
+				return nil, fmt.Errorf("systematic %q: %w", sc.Name, err)
+			}
+			down, err := sc.Down.treeFunc(false)
+			if err != nil {
+				return nil, fmt.Errorf("systematic %q: %w", sc.Name, err)
+			}
+			opts = append(opts, WithSystematic(sc.Name, up, down))
+		case tree && !weight && !branch:
+			opts = append(opts, WithTreeSystematic(sc.Name, sc.UpTree, sc.DownTree))
+		case branch && !weight && !tree:
+			opts = append(opts, WithBranchSystematic(sc.Name, sc.UpBranches, sc.DownBranches))
+		default:
+			err := "%w: systematic %q needs exactly one of up/down, upTree/downTree or upBranches/downBranches"
+			return nil, fmt.Errorf(err, ErrConfig, sc.Name)
+		}
+	}
+	return opts, nil
+}
+
+// Helper function returning the style options of a sample.
+func (st *styleConfig) options() ([]SampleOptions, error) {
+
+	var opts []SampleOptions
+
+	colors := []struct {
+		val string
+		opt func(color.NRGBA) SampleOptions
+	}{
+		{st.LineColor, WithLineColor},
+		{st.FillColor, WithFillColor},
+		{st.CircleColor, WithCircleColor},
+		{st.BandColor, WithBandColor},
+	}
+	for _, c := range colors {
+		if c.val == "" {
+			continue
+		}
+		col, err := parseColor(c.val)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, c.opt(col))
+	}
+
+	flags := []struct {
+		val *bool
+		opt func(bool) SampleOptions
+	}{
+		{st.CircleMarkers, WithCircleMarkers},
+		{st.Band, WithBand},
+		{st.YErrBars, WithYErrBars},
+		{st.DataStyle, WithDataStyle},
+	}
+	for _, f := range flags {
+		if f.val != nil {
+			opts = append(opts, f.opt(*f.val))
+		}
+	}
+
+	if st.LineWidth != nil {
+		opts = append(opts, WithLineWidth(vg.Length(*st.LineWidth)))
+	}
+	if st.CircleSize != nil {
+		opts = append(opts, WithCircleSize(vg.Length(*st.CircleSize)))
+	}
+	if len(st.LineDashes) > 0 {
+		dashes := make([]vg.Length, len(st.LineDashes))
+		for i, d := range st.LineDashes {
+			dashes[i] = vg.Length(d)
+		}
+		opts = append(opts, WithLineDashes(dashes))
+	}
+
+	return opts, nil
+}
+
+// Helper function returning the variable described by vc.
+func (vc *variableConfig) variable() (*Variable, error) {
+
+	f, err := vc.Func.treeFunc(false)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []VariableOptions
+	if vc.SaveName != "" {
+		opts = append(opts, WithSaveName(vc.SaveName))
+	}
+	if len(vc.BinEdges) > 0 {
+		opts = append(opts, WithBinEdges(vc.BinEdges))
+	}
+	if vc.XLabel != "" {
+		opts = append(opts, func(cfg *config) {
+			cfg.XLabel.val = vc.XLabel
+			cfg.XLabel.usr = true
+		})
+	}
+	if vc.YLabel != "" {
+		opts = append(opts, func(cfg *config) {
+			cfg.YLabel.val = vc.YLabel
+			cfg.YLabel.usr = true
+		})
+	}
+	if vc.XTickFormat != "" || vc.YTickFormat != "" {
+		opts = append(opts, WithTickFormats(vc.XTickFormat, vc.YTickFormat))
+	}
+	if vc.LogY != nil {
+		opts = append(opts, WithLogY(*vc.LogY))
+	}
+	if vc.LegLeft != nil {
+		opts = append(opts, WithLegLeft(*vc.LegLeft))
+	}
+	if vc.LegTop != nil {
+		opts = append(opts, WithLegTop(*vc.LegTop))
+	}
+
+	ranges := []struct {
+		name string
+		val  []float64
+		opt  func(min, max float64) VariableOptions
+	}{
+		{"xRange", vc.XRange, WithXRange},
+		{"yRange", vc.YRange, WithYRange},
+		{"ratioYRange", vc.RatioYRange, WithRatioYRange},
+	}
+	for _, r := range ranges {
+		switch len(r.val) {
+		case 0:
+		case 2:
+			opts = append(opts, r.opt(r.val[0], r.val[1]))
+		default:
+			return nil, fmt.Errorf("%w: %s must be [min, max]", ErrConfig, r.name)
+		}
+	}
+
+	if vc.FlowMode != "" {
+		m, err := parseFlowMode(vc.FlowMode)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithVarFlowMode(m))
+	}
+
+	v := NewVariable(vc.Name, f, vc.Nbins, vc.Xmin, vc.Xmax, opts...)
+	if v.err != nil {
+		return nil, v.err
+	}
+	return v, nil
+}
+
+// Helper function returning the TreeFunc described by fc.
+// A cut must return a boolean: it is either a branch,
+// an expression or a registered function.
+func (fc *funcConfig) treeFunc(cut bool) (TreeFunc, error) {
+
+	n := 0
+	for _, set := range []bool{fc.Branch != "", fc.Expr != "", fc.Func != "", fc.Value != nil} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return TreeFunc{}, fmt.Errorf("%w: function needs exactly one of branch, expr, func or value", ErrConfig)
+	}
+
+	switch {
+	case fc.Expr != "":
+		return newTreeExpr(fc.Expr)
+	case fc.Func != "":
+		return registeredTreeFunc(fc.Func)
+	case fc.Value != nil:
+		if cut {
+			return TreeFunc{}, fmt.Errorf("%w: a cut cannot be a value", ErrConfig)
+		}
+		return TreeValF64(*fc.Value), nil
+	}
+
+	if cut {
+		switch fc.Type {
+		case "":
+			return TreeCut(fc.Branch), nil
+		case "bool":
+			return TreeCutBool(fc.Branch), nil
+		}
+		return TreeFunc{}, fmt.Errorf("%w: a cut branch must be of type bool", ErrConfig)
+	}
+
+	switch fc.Type {
+	case "":
+		return TreeVar(fc.Branch), nil
+	case "f32":
+		return TreeVarF32(fc.Branch), nil
+	case "f64":
+		return TreeVarF64(fc.Branch), nil
+	case "i32":
+		return TreeVarI32(fc.Branch), nil
+	case "i64":
+		return TreeVarI64(fc.Branch), nil
+	case "bool":
+		return TreeVarBool(fc.Branch), nil
+	case "f32s":
+		return TreeVarF32s(fc.Branch), nil
+	case "f64s":
+		return TreeVarF64s(fc.Branch), nil
+	case "i32s":
+		return TreeVarI32s(fc.Branch), nil
+	case "i64s":
+		return TreeVarI64s(fc.Branch), nil
+	}
+	return TreeFunc{}, fmt.Errorf("%w: unknown branch type %q", ErrConfig, fc.Type)
+}
+
+// Helper function parsing a '#rrggbb' or '#rrggbbaa' color.
+func parseColor(s string) (color.NRGBA, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	if len(hex) != 8 || !strings.HasPrefix(s, "#") {
+		return color.NRGBA{}, fmt.Errorf("%w: color %q is not '#rrggbb' or '#rrggbbaa'", ErrConfig, s)
+	}
+	c, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, fmt.Errorf("%w: color %q is not '#rrggbb' or '#rrggbbaa'", ErrConfig, s)
+	}
+	return color.NRGBA{R: uint8(c >> 24), G: uint8(c >> 16), B: uint8(c >> 8), A: uint8(c)}, nil
+}
+
+// Helper function parsing a flow mode: 'hide', 'fold' or 'show'.
+func parseFlowMode(s string) (FlowMode, error) {
+	switch s {
+	case "hide":
+		return FlowHide, nil
+	case "fold":
+		return FlowFold, nil
+	case "show":
+		return FlowShow, nil
+	}
+	return FlowDefault, fmt.Errorf("%w: flow mode %q is not 'hide', 'fold' or 'show'", ErrConfig, s)
+}
@@ -0,0 +1,83 @@
+package ana
+
+import (
+	"fmt"
+
+	"github.com/rmadar/tree-gonalyzer/cflow"
+)
+
+// CutFlow returns the cut flows of all samples, the CutFlowCuts
+// being applied cumulatively in the event loops, as cflow cut flows
+// with one column group per sample. The first step ("all") counts
+// the events of the samples, passing their cuts, and each following
+// step adds one of the cuts. The weighted yields are normalized as
+// the histograms (luminosity, cross-section and number of generated
+// events), with their statistical uncertainties, the efficiencies
+// and the S/B and S/sqrt(B) ratios of each step. An error is
+// returned if the event loops were not run.
+func (ana *Maker) CutFlow() (cflow.MultiCutFlow, error) {
+
+	if ana.histoLoaded {
+		err := fmt.Errorf("%w: histograms loaded by NewFromHistos()", ErrNoYields)
+		return cflow.MultiCutFlow{}, &Error{Op: "get cut flow", Err: err}
+	}
+	if ana.cutFlow == nil {
+		err := fmt.Errorf("%w: RunEventLoops() must be called before CutFlow()", ErrNoHistos)
+		return cflow.MultiCutFlow{}, &Error{Op: "get cut flow", Err: err}
+	}
+
+	cuts := make([]string, len(ana.CutFlowCuts)+1)
+	cuts[0] = noSelDirName
+	for k, cut := range ana.CutFlowCuts {
+		cuts[k+1] = cut.Name
+		if cut.Name == "" {
+			cuts[k+1] = fmt.Sprintf("cut%d", k+1)
+		}
+	}
+
+	samples := make([]cflow.Sample, len(ana.Samples))
+	flows := make([]cflow.CutFlow, len(ana.Samples))
+	for is, s := range ana.Samples {
+		samples[is] = cflow.Sample{Name: s.Name, Signal: s.IsSig(), Data: s.IsData()}
+		stages := make([]cflow.Yields, len(cuts))
+		for k, cut := range cuts {
+			c := ana.cutFlow[is][k]
+			stages[k] = cflow.Yields{Name: cut, Raw: float64(c.N), Wgt: c.SumW, Wgt2: c.SumW2}
+		}
+		flows[is] = cflow.NewCutFlow(stages)
+	}
+
+	return cflow.NewMultiCutFlow(samples, flows), nil
+}
+
+// Helper function saving the cut-flow tables in SavePath,
+// in all formats.
+func (ana *Maker) saveCutFlow() error {
+	cf, err := ana.CutFlow()
+	if err != nil {
+		return err
+	}
+	return ana.saveTable("save cut flow", "cutflow", cf)
+}
+
+// RunCutFlows runs the event loops of the samples, as a Maker
+// configured with opts does, and returns the cut flows of the
+// cuts applied cumulatively (see Maker.CutFlow). Samples
+// components, joint trees, weights and cuts are handled as for
+// the histograms, so that both rely on identical definitions.
+// The cut-flow tables are only returned, never saved in SavePath.
+func RunCutFlows(samples []*Sample, cuts []*Selection, opts ...Options) (cflow.MultiCutFlow, error) {
+
+	opts = append(opts[:len(opts):len(opts)], WithCutFlow(cuts))
+	ana, err := New(samples, nil, opts...)
+	if err != nil {
+		return cflow.MultiCutFlow{}, err
+	}
+	ana.noCutFlowSave = true
+
+	if err := ana.RunEventLoops(); err != nil {
+		return cflow.MultiCutFlow{}, err
+	}
+
+	return ana.CutFlow()
+}
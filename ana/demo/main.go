@@ -2,8 +2,12 @@
 package main
 
 import (
+	"context"
 	"flag"
-		
+	"os"
+	"os/signal"
+	"syscall"
+
 	"github.com/rmadar/tree-gonalyzer/ana"
 )
 
@@ -58,11 +62,14 @@ func main() {
 	}
 
 	// Create analyzer object with some selections, enabeling automatic style
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithKinemCuts(selections),
 		ana.WithDumpTree(true),
 		ana.WithSampleMT(true),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Command line options
 	analyzer.NevtsMax = *nMax
@@ -72,8 +79,18 @@ func main() {
 	analyzer.HistoNorm = *doNorm
 	analyzer.HistoStack = *doStack
 
+	// Stop the event loops properly on SIGINT/SIGTERM
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		cancel()
+	}()
+
 	// Run the analyzer and produce all plots
-	if err := analyzer.Run(); err != nil {
+	if err := analyzer.RunContext(ctx); err != nil {
 		panic(err)
 	}
 
@@ -0,0 +1,120 @@
+package ana
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrSampleType is returned when a sample type is not
+	// one of 'data', 'bkg' or 'sig'.
+	ErrSampleType = errors.New("sample type not supported")
+
+	// ErrDataOption is returned when a normalisation option
+	// (cross-section, Ngen) is passed to a data sample.
+	ErrDataOption = errors.New("option not supported by data samples")
+
+	// ErrDataSamples is returned when more than one data
+	// sample is given to the analysis maker.
+	ErrDataSamples = errors.New("more than one data sample")
+
+	// ErrFuncType is returned when the TreeFunc.Fct returned
+	// type doesn't match its usage (variable, cut or weight).
+	ErrFuncType = errors.New("type assertion failed")
+
+	// ErrNoHistos is returned when histograms are plotted
+	// before being filled.
+	ErrNoHistos = errors.New("histograms are not filled")
+
+	// ErrNoYields is returned when the yields or the cut flow
+	// are asked for histograms loaded with NewFromHistos, which
+	// are saved without them.
+	ErrNoYields = errors.New("yields are not saved with the histograms")
+
+	// ErrBinEdges is returned when the bin edges of a
+	// variable are not valid.
+	ErrBinEdges = errors.New("invalid bin edges")
+
+	// ErrRebin is returned when a saved histogram cannot
+	// be rebinned to the binning of its variable.
+	ErrRebin = errors.New("incompatible binning")
+
+	// ErrConfig is returned when a configuration file
+	// is not valid.
+	ErrConfig = errors.New("invalid configuration")
+
+	// ErrExpr is returned when an expression of TreeExpr
+	// is not valid.
+	ErrExpr = errors.New("invalid expression")
+
+	// ErrRegistry is returned when a TreeFunc or a formula
+	// cannot be registered, or is not registered.
+	ErrRegistry = errors.New("tree function registry")
+
+	// ErrSelectionName is returned when a selection is named
+	// as the directory of the selection without name, ie "all",
+	// in which their histograms are saved.
+	ErrSelectionName = errors.New("reserved selection name")
+)
+
+// Error is the error type returned by the analysis maker.
+// It wraps the original error with the context in which it
+// occured, ie the sample, the component file, the tree, and
+// the variable or the selection involved (if any).
+type Error struct {
+	Op        string // Failed operation.
+	Sample    string // Sample name.
+	File      string // Component file name.
+	Tree      string // Tree name.
+	Variable  string // Variable name.
+	Selection string // Selection name.
+	Err       error  // Original error.
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	ctx := []string{}
+	add := func(key, val string) {
+		if val != "" {
+			ctx = append(ctx, fmt.Sprintf("%s=%q", key, val))
+		}
+	}
+	add("sample", e.Sample)
+	add("file", e.File)
+	add("tree", e.Tree)
+	add("variable", e.Variable)
+	add("selection", e.Selection)
+
+	msg := "ana: " + e.Op
+	if len(ctx) > 0 {
+		msg += " [" + strings.Join(ctx, ", ") + "]"
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the original error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// InterruptError is returned when the event loops are stopped
+// by their context before the end. It reports the number of
+// events processed before the interruption.
+type InterruptError struct {
+	NEvents int64 // Number of processed events.
+	Err     error // Context error.
+}
+
+// Error implements the error interface.
+func (e *InterruptError) Error() string {
+	return fmt.Sprintf("ana: event loops interrupted after %d events: %v", e.NEvents, e.Err)
+}
+
+// Unwrap returns the context error.
+func (e *InterruptError) Unwrap() error {
+	return e.Err
+}
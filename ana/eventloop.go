@@ -1,10 +1,10 @@
 package ana
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
 	"math"
-	"os"
 	"sync"
 	"time"
 
@@ -14,300 +14,936 @@ import (
 	"go-hep.org/x/hep/hbook"
 )
 
-// RunEventLoops runs one event loop per sample to fill
+const (
+	chunksPerWorker = 4    // Targeted number of entry ranges per worker.
+	chunkSizeMin    = 1000 // Minimal number of entries of a range.
+)
+
+// chunk is the half-open range [beg, end) of entries of
+// a sample component. A negative end means all entries.
+// A non-negative iSyst refers to the up (or down) variation
+// of a tree-based or branch-based systematic. The mask tells
+// which histograms are filled, all of them if nil.
+type chunk struct {
+	iSamp, iComp int
+	beg, end     int64
+	iSyst        int
+	up           bool
+	mask         *fillMask
+}
+
+// job is a list of chunks of a sample processed in order
+// by one worker, filling its own set of histograms. If the
+// cache is used, all chunks belong to the component iComp.
+type job struct {
+	iSamp  int
+	iComp  int
+	chunks []chunk
+}
+
+// jobResult contains everything filled by a job.
+type jobResult struct {
+	h     [][]*hbook.H1D    // Histograms h[iCut][iVar].
+	h2    [][]*hbook.H2D    // 2D histograms h2[iCut][iVar2D].
+	hp    [][]profileHistos // Profiles hp[iCut][iProf].
+	hs    []systHistos      // Varied histograms hs[iSyst].
+	y     []yieldCount      // Yields y[iCut] of the nominal pass.
+	cf    []yieldCount      // Cut-flow counts cf[iStep] of the nominal pass.
+	nEvts int64             // Number of processed events.
+}
+
+// RunEventLoops runs the event loops over all samples to fill
 // histograms for each variables and selections. If DumpTree
 // is true, a tree is also dumped with all variables and one
 // branch per selection.
+//
+// By default, there is one event loop per sample, concurrent
+// if SampleMT is true. If NWorkers>0, the sample components are
+// split into entry ranges processed by NWorkers concurrent
+// workers. In both cases, histograms are filled separately for
+// each loop (or range) and merged in the same order, so that the
+// result doesn't depend on the scheduling. The order of the events
+// in dumped trees does depend on it, if NWorkers>1.
 func (ana *Maker) RunEventLoops() error {
+	return ana.RunEventLoopsContext(context.Background())
+}
+
+// RunEventLoopsContext runs the event loops as RunEventLoops does,
+// but stops all of them as soon as the context is done. In that case,
+// dumped trees are closed with the events processed so far and the
+// returned error is an *InterruptError, reporting the number of
+// processed events. All event loops are also stopped as soon as
+// one of them fails, and its error is returned even if the context
+// is done.
+func (ana *Maker) RunEventLoopsContext(ctx context.Context) error {
 
 	// Start timing
 	start := time.Now()
 
 	// Initialize hbook H1D as N[samples] 2D-slices.
 	ana.hbookHistos = make([][][]*hbook.H1D, len(ana.Samples))
+	ana.hbookHistos2D = make([][][]*hbook.H2D, len(ana.Samples))
+	ana.hbookProfiles = make([][][]profileHistos, len(ana.Samples))
+	ana.hbookSysts = make([][]systHistos, len(ana.Samples))
+	ana.yields = make([][]yieldCount, len(ana.Samples))
+	ana.cutFlow = make([][]yieldCount, len(ana.Samples))
+	ana.systNames = ana.systematicNames()
+	ana.histoFilled = false
+	ana.histoLoaded = false
+
+	// Look for the cached histograms.
+	ana.cache = nil
+	if ana.useCache() {
+		ana.cache = ana.newCaches()
+	}
 
-	// Loop over the samples
-	if ana.SampleMT {
-		var wg sync.WaitGroup
-		wg.Add(len(ana.Samples))
-		for i := range ana.Samples {
-			go ana.concurrentSampleEventLoop(i, &wg)
-		}
-		wg.Wait()
-	} else {
-		for i := range ana.Samples {
-			ana.sampleEventLoop(i)
+	// Reset the event counting.
+	ana.nEvents = 0
+	ana.nEvtsSample = make([]int64, len(ana.Samples))
+
+	// Split the work into jobs.
+	jobs, err := ana.makeJobs()
+	if err != nil {
+		return err
+	}
+
+	// Create the dumped trees, one per sample.
+	var outs []*treeOut
+	if ana.DumpTree {
+		if outs, err = ana.newTreeOuts(); err != nil {
+			return err
 		}
 	}
 
+	// Stop all jobs at the first failure.
+	ctxLoop, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Run the jobs on a pool of workers, keeping track of errors.
+	res := make([]jobResult, len(jobs))
+	errs := make([]error, len(jobs))
+	jobIdx := make(chan int)
+	nWorkers := ana.nWorkers(len(jobs))
+	var wg sync.WaitGroup
+	wg.Add(nWorkers)
+	for w := 0; w < nWorkers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobIdx {
+				if res[i], errs[i] = ana.runJob(ctxLoop, jobs[i], outs); errs[i] != nil {
+					cancel()
+				}
+			}
+		}()
+	}
+	for i := range jobs {
+		jobIdx <- i
+	}
+	close(jobIdx)
+	wg.Wait()
+
+	// Close the dumped trees with the processed events.
+	errClose := closeTreeOuts(ana.Samples, outs)
+
+	for i, jb := range jobs {
+		ana.nEvtsSample[jb.iSamp] += res[i].nEvts
+	}
 	for _, n := range ana.nEvtsSample {
 		ana.nEvents += n
+	}
+
+	// End timing.
+	ana.timeLoop = time.Since(start)
+
+	// Report the first error, following the job order and
+	// ignoring the cancellations it caused, or the user context.
+	interrupted := false
+	for _, err := range errs {
+		switch {
+		case err == nil:
+		case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+			interrupted = true
+		default:
+			return err
+		}
+	}
 
+	// Report the interruption by the user context, if it stopped
+	// a job: the context being done after all the jobs completed
+	// doesn't affect the result.
+	if err := ctx.Err(); err != nil && interrupted {
+		return &InterruptError{NEvents: ana.nEvents, Err: err}
 	}
+	if errClose != nil {
+		return errClose
+	}
+
+	// Merge the histograms following the job order, to
+	// get a result independent of the scheduling.
+	sampRes := make([]*jobResult, len(ana.Samples))
+	merge := func(is int, r *jobResult) {
+		if sampRes[is] == nil {
+			sampRes[is] = r
+			return
+		}
+		sampRes[is].add(r)
+	}
+	if ana.cache == nil {
+		for i, jb := range jobs {
+			merge(jb.iSamp, &res[i])
+		}
+	} else {
+		// Merge the components first, completing and
+		// updating their cache.
+		compRes := make([][]*jobResult, len(ana.Samples))
+		for is, samp := range ana.Samples {
+			compRes[is] = make([]*jobResult, len(samp.components))
+		}
+		for i, jb := range jobs {
+			if r := compRes[jb.iSamp][jb.iComp]; r != nil {
+				r.add(&res[i])
+			} else {
+				compRes[jb.iSamp][jb.iComp] = &res[i]
+			}
+		}
+		for is := range compRes {
+			for ic := range compRes[is] {
+				r, err := ana.cachedResult(is, ic, compRes[is][ic])
+				if err != nil {
+					return err
+				}
+				merge(is, r)
+			}
+		}
+	}
+
+	// Samples without components have empty histograms.
+	for i, r := range sampRes {
+		if r == nil {
+			ana.hbookHistos[i] = ana.newHistos()
+			ana.hbookHistos2D[i] = ana.newHistos2D()
+			ana.hbookProfiles[i] = ana.newProfiles()
+			ana.hbookSysts[i] = ana.newSystHistos(ana.Samples[i])
+			ana.yields[i] = make([]yieldCount, len(ana.KinemCuts))
+			ana.cutFlow[i] = make([]yieldCount, len(ana.CutFlowCuts)+1)
+			continue
+		}
+		ana.hbookHistos[i] = r.h
+		ana.hbookHistos2D[i] = r.h2
+		ana.hbookProfiles[i] = r.hp
+		ana.hbookSysts[i] = r.hs
+		ana.yields[i] = r.y
+		ana.cutFlow[i] = r.cf
+	}
+
 	// Histograms are now filled.
 	ana.histoFilled = true
 
-	// End timing.
-	ana.timeLoop = time.Since(start)
+	// Save them, and the yields, if required.
+	if ana.SaveHistos != "" {
+		if err := ana.saveHistos(ana.SavePath + "/" + ana.SaveHistos); err != nil {
+			return err
+		}
+	}
+	if ana.SaveYields {
+		if err := ana.saveYields(); err != nil {
+			return err
+		}
+	}
+	if len(ana.CutFlowCuts) > 0 && !ana.noCutFlowSave {
+		return ana.saveCutFlow()
+	}
 
 	return nil
 }
 
-func (ana *Maker) concurrentSampleEventLoop(sampleIdx int, wg *sync.WaitGroup) {
+// Helper function returning the number of concurrent workers.
+func (ana *Maker) nWorkers(nJobs int) int {
+	n := 1
+	switch {
+	case ana.NWorkers > 0:
+		n = ana.NWorkers
+	case ana.SampleMT:
+		n = nJobs
+	}
+	if n > nJobs {
+		n = nJobs
+	}
+	return n
+}
+
+// Helper function splitting the samples into jobs. By default,
+// there is one job per sample, with one chunk per component.
+// If NWorkers>0, there is one job per chunk, their size being
+// set to have about chunksPerWorker chunks per worker.
+func (ana *Maker) makeJobs() ([]job, error) {
+
+	jobs := []job{}
+
+	// One job per sample, or per component not
+	// fully cached if the cache is used.
+	if ana.NWorkers <= 0 {
+		for is, samp := range ana.Samples {
+			if len(samp.components) == 0 {
+				continue
+			}
+			jb := job{iSamp: is}
+			for ic := range samp.components {
+				if ana.cache == nil {
+					jb.chunks = append(jb.chunks, ana.componentPasses(is, ic)...)
+					continue
+				}
+				if m := ana.fillMaskOf(is, ic); m == nil || m.any() {
+					cs := ana.componentPasses(is, ic)
+					jobs = append(jobs, job{iSamp: is, iComp: ic, chunks: cs})
+				}
+			}
+			if ana.cache == nil {
+				jobs = append(jobs, jb)
+			}
+		}
+		return jobs, nil
+	}
+
+	// Number of entries to process for each pass over a component.
+	passes := []chunk{}
+	nEntries := []int64{}
+	nTot := int64(0)
+	for is, samp := range ana.Samples {
+		for ic, comp := range samp.components {
+			if m := ana.fillMaskOf(is, ic); m != nil && !m.any() {
+				continue
+			}
+			for _, c := range ana.componentPasses(is, ic) {
+				tname := ana.passTree(c)
+				n, err := ana.componentEntries(comp.FileName, tname)
+				if err != nil {
+					return nil, &Error{
+						Op:     "get tree",
+						Sample: samp.Name,
+						File:   comp.FileName,
+						Tree:   tname,
+						Err:    err,
+					}
+				}
+				passes = append(passes, c)
+				nEntries = append(nEntries, n)
+				nTot += n
+			}
+		}
+	}
+
+	// Size of the entry ranges.
+	size := nTot / int64(chunksPerWorker*ana.NWorkers)
+	if size < chunkSizeMin {
+		size = chunkSizeMin
+	}
+
+	// One job per entry range.
+	for i, pass := range passes {
+		n := nEntries[i]
+		for beg := int64(0); beg < n; beg += size {
+			end := beg + size
+			if end > n {
+				end = n
+			}
+			c := pass
+			c.beg, c.end = beg, end
+			jobs = append(jobs, job{iSamp: c.iSamp, iComp: c.iComp, chunks: []chunk{c}})
+		}
+	}
+
+	return jobs, nil
+}
 
-	// Handle concurrency
-	defer wg.Done()
+// Helper function returning the chunks covering all entries
+// of a component, for the nominal pass and for the up and
+// down passes of each tree-based or branch-based systematic.
+func (ana *Maker) componentPasses(is, ic int) []chunk {
+	samp := ana.Samples[is]
+	comp := samp.components[ic]
+	mask := ana.fillMaskOf(is, ic)
+	cs := []chunk{{iSamp: is, iComp: ic, beg: 0, end: -1, iSyst: -1, mask: mask}}
+	for k := range ana.systNames {
+		if _, ok := ana.variedSystematic(samp, comp, k); !ok {
+			continue
+		}
+		for _, up := range []bool{true, false} {
+			cs = append(cs, chunk{iSamp: is, iComp: ic, beg: 0, end: -1, iSyst: k, up: up, mask: mask})
+		}
+	}
+	return cs
+}
 
-	// Fill the histo
-	ana.sampleEventLoop(sampleIdx)
+// Helper function returning the name of the main tree
+// read by a chunk.
+func (ana *Maker) passTree(c chunk) string {
+	samp := ana.Samples[c.iSamp]
+	comp := samp.components[c.iComp]
+	if c.iSyst < 0 {
+		return comp.TreeName
+	}
+	if syst, _ := ana.variedSystematic(samp, comp, c.iSyst); syst.Kind == treeSyst {
+		if tname := syst.tree(c.up); tname != "" {
+			return tname
+		}
+	}
+	return comp.TreeName
 }
 
-func (ana *Maker) sampleEventLoop(sampleIdx int) {
+// Helper function returning the number of entries
+// to process for a tree of a file.
+func (ana *Maker) componentEntries(fname, tname string) (int64, error) {
+	f, t, err := getTreeFromFile(fname, tname)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
 
-	// Current sample
-	samp := ana.Samples[sampleIdx]
+	n := t.Entries()
+	if ana.NevtsMax >= 0 && ana.NevtsMax < n {
+		n = ana.NevtsMax
+	}
+	return n, nil
+}
 
-	// Initiate the structure of the histo container: h[iCut][iVar]
+// Helper function creating the histograms h[iCut][iVar].
+func (ana *Maker) newHistos() [][]*hbook.H1D {
 	h := make([][]*hbook.H1D, len(ana.KinemCuts))
 	for iCut := range ana.KinemCuts {
 		h[iCut] = make([]*hbook.H1D, len(ana.Variables))
 		for iVar, v := range ana.Variables {
-			if ana.PlotHisto {
+			switch {
+			case ana.PlotHisto && len(v.BinEdges) > 0:
+				h[iCut][iVar] = hbook.NewH1DFromEdges(v.BinEdges)
+			case ana.PlotHisto:
 				h[iCut][iVar] = hbook.NewH1D(v.Nbins, v.Xmin, v.Xmax)
-			} else {
+			default:
 				h[iCut][iVar] = hbook.NewH1D(1, 0, 1)
 			}
 		}
 	}
+	return h
+}
+
+// Helper function creating the 2D histograms h2[iCut][iVar2D].
+func (ana *Maker) newHistos2D() [][]*hbook.H2D {
+	h := make([][]*hbook.H2D, len(ana.KinemCuts))
+	for iCut := range ana.KinemCuts {
+		h[iCut] = make([]*hbook.H2D, len(ana.Variables2D))
+		for iVar, v := range ana.Variables2D {
+			if ana.PlotHisto {
+				h[iCut][iVar] = hbook.NewH2D(v.Nx, v.Xmin, v.Xmax, v.Ny, v.Ymin, v.Ymax)
+			} else {
+				h[iCut][iVar] = hbook.NewH2D(1, 0, 1, 1, 0, 1)
+			}
+		}
+	}
+	return h
+}
+
+// Helper function creating the profiles hp[iCut][iProf].
+func (ana *Maker) newProfiles() [][]profileHistos {
+	h := make([][]profileHistos, len(ana.KinemCuts))
+	for iCut := range ana.KinemCuts {
+		h[iCut] = make([]profileHistos, len(ana.Profiles))
+		for iProf, p := range ana.Profiles {
+			if ana.PlotHisto {
+				h[iCut][iProf] = newProfileHistos(p.Nbins, p.Xmin, p.Xmax)
+			} else {
+				h[iCut][iProf] = newProfileHistos(1, 0, 1)
+			}
+		}
+	}
+	return h
+}
+
+// Helper function adding the content of src to dst,
+// assuming they have the same binning.
+func addH2D(dst, src *hbook.H2D) {
+	add1D := func(d *hbook.Dist1D, s hbook.Dist1D) {
+		d.Dist.N += s.Dist.N
+		d.Dist.SumW += s.Dist.SumW
+		d.Dist.SumW2 += s.Dist.SumW2
+		d.Stats.SumWX += s.Stats.SumWX
+		d.Stats.SumWX2 += s.Stats.SumWX2
+	}
+	add := func(d *hbook.Dist2D, s hbook.Dist2D) {
+		add1D(&d.X, s.X)
+		add1D(&d.Y, s.Y)
+		d.Stats.SumWXY += s.Stats.SumWXY
+	}
+	for i := range dst.Binning.Bins {
+		add(&dst.Binning.Bins[i].Dist, src.Binning.Bins[i].Dist)
+	}
+	for i := range dst.Binning.Outflows {
+		add(&dst.Binning.Outflows[i], src.Binning.Outflows[i])
+	}
+	add(&dst.Binning.Dist, src.Binning.Dist)
+}
+
+// Helper function adding the histograms of src to r.
+func (r *jobResult) add(src *jobResult) {
+	addSystHistos(r.hs, src.hs)
+	for ic := range r.y {
+		r.y[ic].add(src.y[ic])
+	}
+	for k := range r.cf {
+		r.cf[k].add(src.cf[k])
+	}
+	for ic := range r.h {
+		for iv := range r.h[ic] {
+			r.h[ic][iv] = hbook.AddH1D(r.h[ic][iv], src.h[ic][iv])
+		}
+		for iv := range r.h2[ic] {
+			addH2D(r.h2[ic][iv], src.h2[ic][iv])
+		}
+		for ip := range r.hp[ic] {
+			r.hp[ic][ip] = r.hp[ic][ip].add(src.hp[ic][ip])
+		}
+	}
+	r.nEvts += src.nEvts
+}
+
+// Helper function returning an empty job result for a sample.
+func (ana *Maker) newJobResult(samp *Sample) jobResult {
+	return jobResult{
+		h:  ana.newHistos(),
+		h2: ana.newHistos2D(),
+		hp: ana.newProfiles(),
+		hs: ana.newSystHistos(samp),
+		y:  make([]yieldCount, len(ana.KinemCuts)),
+		cf: make([]yieldCount, len(ana.CutFlowCuts)+1),
+	}
+}
+
+// Helper function running the chunks of a job, in order.
+func (ana *Maker) runJob(ctx context.Context, jb job, outs []*treeOut) (jobResult, error) {
+
+	res := ana.newJobResult(ana.Samples[jb.iSamp])
+
+	for _, c := range jb.chunks {
+
+		// Do not start a new chunk if the loop is stopped.
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+
+		if err := ana.runChunk(ctx, c, &res, outs); err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
+
+// Helper function running the event loop over a chunk,
+// filling the job result and the dumped tree, if any.
+func (ana *Maker) runChunk(ctx context.Context, c chunk, res *jobResult, outs []*treeOut) error {
+
+	// Current sample and component
+	samp := ana.Samples[c.iSamp]
+	comp := samp.components[c.iComp]
+	h, h2, hp, hs := res.h, res.h2, res.hp, res.hs
+	vars2D, profs := ana.Variables2D, ana.Profiles
 
 	// Output in case of TTree dumping
-	path := ana.SavePath + "/ntuples/"
-	if _, err := os.Stat(path); os.IsNotExist(err) && ana.DumpTree {
-		os.MkdirAll(path, 0755)
+	var out *treeOut
+	if ana.DumpTree {
+		out = outs[c.iSamp]
 	}
-	var fOut *groot.File
-	var tOut rtree.Writer
 	dump := ana.newDumper()
-	if ana.DumpTree {
-		fOut, tOut = ana.getOutFileTree(path+samp.Name+".root", "GOtree", dump)
-		defer fOut.Close()
-		defer tOut.Close()
+
+	// A varied pass only fills the varied histograms of variables,
+	// reading the alternative tree or branches.
+	varied := c.iSyst >= 0
+	tname := ana.passTree(c)
+	var branches map[string]string
+	if varied {
+		syst, _ := ana.variedSystematic(samp, comp, c.iSyst)
+		if syst.Kind == branchSyst {
+			branches = syst.branches(c.up)
+		}
+		h = hs[c.iSyst].down
+		if c.up {
+			h = hs[c.iSyst].up
+		}
+		vars2D, profs, out = nil, nil, nil
 	}
 
-	// Loop over the sample components
-	for iComp, comp := range samp.components {
+	// Helper to add the component context to an error.
+	compErr := func(op string, err error) *Error {
+		return &Error{
+			Op:     op,
+			Sample: samp.Name,
+			File:   comp.FileName,
+			Tree:   tname,
+			Err:    err,
+		}
+	}
 
-		// Anonymous function to avoid memory-leaks due to 'defer'
-		func(j int) error {
+	// Get the main file and tree
+	f, tMain, err := getTreeFromFile(comp.FileName, tname)
+	if err != nil {
+		return compErr("get tree", err)
+	}
+	defer f.Close()
+
+	// Get the trees to be joint
+	trees := []rtree.Tree{tMain}
+	for _, in := range comp.JointTrees {
+		fJoin, tJoin, err := getTreeFromFile(in.FileName, in.TreeName)
+		if err != nil {
+			e := compErr("get joint tree", err)
+			e.File, e.Tree = in.FileName, in.TreeName
+			return e
+		}
+		trees = append(trees, tJoin)
+		defer fJoin.Close()
+	}
+	t, err := rtree.Join(trees...)
+	if err != nil {
+		return compErr("join trees", err)
+	}
 
-			// Get the main file and tree
-			f, tMain := getTreeFromFile(comp.FileName, comp.TreeName)
-			defer f.Close()
+	// Get the tree reader over the entry range
+	end := c.end
+	if end < 0 {
+		end = int64(math.Min(float64(t.Entries()), float64(ana.NevtsMax)))
+	}
+	r, err := rtree.NewReader(t, []rtree.ReadVar{}, rtree.WithRange(c.beg, end))
+	if err != nil {
+		return compErr("create tree reader", err)
+	}
+	defer r.Close()
+
+	// Prepare variables
+	getF64 := make([]func() float64, len(ana.Variables))
+	getF64s := make([]func() []float64, len(ana.Variables))
+	for iv, v := range ana.Variables {
+		if !c.mask.variable(iv) {
+			continue
+		}
+		if !v.isSlice {
+			getF64[iv], err = v.TreeFunc.renamed(branches).funcF64(r)
+		} else {
+			getF64s[iv], err = v.TreeFunc.renamed(branches).funcF64s(r)
+		}
+		if err != nil {
+			e := compErr("bind variable", err)
+			e.Variable = v.Name
+			return e
+		}
+	}
 
-			// Get the trees to be joint
-			trees := []rtree.Tree{tMain}
-			for _, in := range comp.JointTrees {
-				fJoin, tJoin := getTreeFromFile(in.FileName, in.TreeName)
-				trees = append(trees, tJoin)
-				defer fJoin.Close()
+	// Prepare two-dimensional variables
+	getXY := make([][2]func() float64, len(vars2D))
+	getXYs := make([][2]func() []float64, len(vars2D))
+	for iv, v := range vars2D {
+		if !c.mask.variable2D(iv) {
+			continue
+		}
+		for i, f := range []TreeFunc{v.XTreeFunc, v.YTreeFunc} {
+			if !v.isSlice {
+				getXY[iv][i], err = f.funcF64(r)
+			} else {
+				getXYs[iv][i], err = f.funcF64s(r)
 			}
-			t, err := rtree.Join(trees...)
 			if err != nil {
-				log.Fatalf("could not join trees: %+v", err)
+				e := compErr("bind 2D variable", err)
+				e.Variable = v.Name
+				return e
 			}
+		}
+	}
 
-			// Get the tree reader
-			nEvtsMax := int64(math.Min(float64(t.Entries()), float64(ana.NevtsMax)))
-			r, err := rtree.NewReader(t, []rtree.ReadVar{}, rtree.WithRange(0, nEvtsMax))
+	// Prepare profiles
+	getProfXY := make([][2]func() float64, len(profs))
+	getProfXYs := make([][2]func() []float64, len(profs))
+	for ip, p := range profs {
+		if !c.mask.profile(ip) {
+			continue
+		}
+		for i, f := range []TreeFunc{p.XTreeFunc, p.YTreeFunc} {
+			if !p.isSlice {
+				getProfXY[ip][i], err = f.funcF64(r)
+			} else {
+				getProfXYs[ip][i], err = f.funcF64s(r)
+			}
 			if err != nil {
-				log.Fatal("could not create tree reader: %w", err)
+				e := compErr("bind profile", err)
+				e.Variable = p.Name
+				return e
 			}
-			defer r.Close()
+		}
+	}
 
-			// Prepare variables
-			ok := false
-			getF64 := make([]func() float64, len(ana.Variables))
-			getF64s := make([]func() []float64, len(ana.Variables))
-			for iv, v := range ana.Variables {
-				idx := iv
-				if !v.isSlice {
-					if getF64[idx], ok = v.TreeFunc.GetFuncF64(r); !ok {
-						err := "Type assertion failed [variable \"%v\"]:"
-						err += " this TreeFunc.Fct is supposed to return a float64."
-						log.Fatalf(err, v.Name)
-					}
-				} else {
-					if getF64s[idx], ok = v.TreeFunc.GetFuncF64s(r); !ok {
-						err := "Type assertion failed [variable \"%v\"]:"
-						err += " this TreeFunc.Fct is supposed to return a []float64."
-						log.Fatalf(err, v.Name)
-					}
-				}
-			}
+	// Prepare the sample global weight
+	getWeightSamp := func() float64 { return 1.0 }
+	if samp.WeightFunc.isSet() {
+		if getWeightSamp, err = samp.WeightFunc.renamed(branches).funcF64(r); err != nil {
+			return compErr("bind sample weight", err)
+		}
+	}
 
-			// Prepare the sample global weight
-			getWeightSamp := func() float64 { return 1.0 }
-			if samp.WeightFunc.Fct != nil {
-				if getWeightSamp, ok = samp.WeightFunc.GetFuncF64(r); !ok {
-					err := "Type assertion failed [weight of %v]:"
-					err += " TreeFunc.Fct must return a float64."
-					log.Fatalf(err, samp.Name)
-				}
-			}
+	// Prepare the normalization weight of this component
+	normWeight := ana.Lumi * 1000 * comp.Xsec / comp.Ngen
+	if samp.sType == data {
+		normWeight = 1.0
+	}
 
-			// Prepare the normalization weight of this component
-			normWeight := ana.Lumi * 1000 * comp.Xsec / comp.Ngen
-			if samp.sType == data {
-				normWeight = 1.0
-			}
+	// Prepare the additional weight of the component
+	getWeightComp := func() float64 { return 1.0 }
+	if comp.WeightFunc.isSet() {
+		if getWeightComp, err = comp.WeightFunc.renamed(branches).funcF64(r); err != nil {
+			return compErr("bind component weight", err)
+		}
+	}
 
-			// Prepare the additional weight of the component
-			getWeightComp := func() float64 { return 1.0 }
-			if comp.WeightFunc.Fct != nil {
-				if getWeightComp, ok = comp.WeightFunc.GetFuncF64(r); !ok {
-					err := "Type assertion failed [weight of (%v, %v)]:"
-					err += " TreeFunc.Fct must return a float64."
-					log.Fatalf(err, samp.Name, comp.FileName)
-				}
-			}
+	// Prepare the systematic variations of the weight, as
+	// the product of the sample and component factors. The
+	// tree-based and branch-based variations of the component
+	// are filled by their own passes, which only bind their
+	// own weight factors, if any.
+	type systWeight struct {
+		iSyst    int
+		up, down []func() float64
+	}
+	systs := []systWeight{}
+	var passFactors []func() float64
+	for k, name := range ana.systNames {
+		if hs[k].up == nil {
+			continue
+		}
+		if varied && k != c.iSyst {
+			continue
+		}
+		if _, ok := ana.variedSystematic(samp, comp, k); ok && !varied {
+			continue
+		}
+		up, down, err := bindSystWeights(r, samp, comp, name, branches)
+		if err != nil {
+			return compErr("bind systematic", err)
+		}
+		switch {
+		case !varied:
+			systs = append(systs, systWeight{iSyst: k, up: up, down: down})
+		case k == c.iSyst && c.up:
+			passFactors = up
+		case k == c.iSyst:
+			passFactors = down
+		}
+	}
+	wUp, wDown := make([]float64, len(systs)), make([]float64, len(systs))
 
-			// Prepare the sample global cut
-			passCutSamp := func() bool { return true }
-			if samp.CutFunc.Fct != nil {
-				if passCutSamp, ok = samp.CutFunc.GetFuncBool(r); !ok {
-					err := "Type assertion failed [Cut of %v]:"
-					err += " TreeFunc.Fct must return a bool.\n"
-					err += "\t -> Make sure to use NewCutBool(), not NewVarBool()."
-					log.Fatalf(err, samp.Name)
-				}
+	// Prepare the sample global cut
+	passCutSamp := func() bool { return true }
+	if samp.CutFunc.isSet() {
+		if passCutSamp, err = samp.CutFunc.renamed(branches).funcBool(r); err != nil {
+			return compErr("bind sample cut", err)
+		}
+	}
+
+	// Prepare the component additional cut
+	passCutComp := func() bool { return true }
+	if comp.CutFunc.isSet() {
+		if passCutComp, err = comp.CutFunc.renamed(branches).funcBool(r); err != nil {
+			return compErr("bind component cut", err)
+		}
+	}
+
+	// Prepare the cut string for kinematics
+	passKinemCut := make([]func() bool, len(ana.KinemCuts))
+	for ic, cut := range ana.KinemCuts {
+		if passKinemCut[ic], err = cut.TreeFunc.renamed(branches).funcBool(r); err != nil {
+			e := compErr("bind selection", err)
+			e.Selection = cut.Name
+			return e
+		}
+	}
+
+	// Prepare the cumulative cuts of the cut flow,
+	// counted by the nominal pass only.
+	var passCutFlow []func() bool
+	if !varied {
+		passCutFlow = make([]func() bool, len(ana.CutFlowCuts))
+		for k, cut := range ana.CutFlowCuts {
+			if passCutFlow[k], err = cut.TreeFunc.funcBool(r); err != nil {
+				e := compErr("bind cut flow", err)
+				e.Selection = cut.Name
+				return e
 			}
+		}
+	}
+
+	// Read the tree (event loop), stopping it when
+	// the context is done.
+	done := ctx.Done()
+	err = r.Read(func(rctx rtree.RCtx) error {
+
+		// Check the context without blocking.
+		select {
+		case <-done:
+			return ctx.Err()
+		default:
+		}
+
+		// Keep track of the number of processed events.
+		if !varied {
+			res.nEvts++
+		}
+
+		// Sample-level and component-level cut
+		if !(passCutSamp() && passCutComp()) {
+			return nil
+		}
+
+		// Get the event weight
+		w := getWeightSamp() * getWeightComp() * normWeight
+		for _, f := range passFactors {
+			w *= f()
+		}
 
-			// Prepare the component additional cut
-			passCutComp := func() bool { return true }
-			if comp.CutFunc.Fct != nil {
-				if passCutComp, ok = comp.CutFunc.GetFuncBool(r); !ok {
-					err := "Type assertion failed [Cut of (%v, %v)]:"
-					err += " TreeFunc.Fct must return a bool.\n"
-					err += "\t -> Make sure to use NewCutBool(), not NewVarBool()."
-					log.Fatalf(err, samp.Name, comp.FileName)
+		// Count the cut flow
+		if !varied {
+			count := yieldCount{N: 1, SumW: w, SumW2: w * w}
+			res.cf[0].add(count)
+			for k, pass := range passCutFlow {
+				if !pass() {
+					break
 				}
+				res.cf[k+1].add(count)
 			}
+		}
 
-			// Prepare the cut string for kinematics
-			passKinemCut := make([]func() bool, len(ana.KinemCuts))
-			for ic, cut := range ana.KinemCuts {
-				idx := ic
-				if passKinemCut[idx], ok = cut.TreeFunc.GetFuncBool(r); !ok {
-					err := "Type assertion failed [selection \"%v\"]:"
-					err += " TreeFunc.Fct must return a bool.\n"
-					err += "\t -> Make sure to use NewCutBool(), not NewVarBool()."
-					log.Fatal(fmt.Sprintf(err, cut.Name))
-				}
+		// Get the varied event weights
+		for j, sw := range systs {
+			wUp[j], wDown[j] = w, w
+			for i := range sw.up {
+				wUp[j] *= sw.up[i]()
+				wDown[j] *= sw.down[i]()
 			}
+		}
 
-			// Read the tree (event loop)
-			err = r.Read(func(ctx rtree.RCtx) error {
+		// Loop over selection and variables
+		for ic := range ana.KinemCuts {
 
-				// Sample-level and component-level cut
-				if !(passCutSamp() && passCutComp()) {
-					return nil
-				}
+			// Look at the next selection if the event is not selected.
+			if !passKinemCut[ic]() {
+				dump.Var[ana.nVars+ic] = 0.0
+				continue
+			} else {
+				dump.Var[ana.nVars+ic] = 1.0
+			}
 
-				// Get the event weight
-				w := getWeightSamp() * getWeightComp() * normWeight
+			// Count the selected events of the nominal pass.
+			if !varied {
+				res.y[ic].add(yieldCount{N: 1, SumW: w, SumW2: w * w})
+			}
 
-				// Loop over selection and variables
-				for ic := range ana.KinemCuts {
+			// Otherwise, loop over variables.
+			for iv, v := range ana.Variables {
+				if !c.mask.variable(iv) {
+					continue
+				}
 
-					// Look at the next selection if the event is not selected.
-					if !passKinemCut[ic]() {
-						dump.Var[ana.nVars+ic] = 0.0
-						continue
-					} else {
-						dump.Var[ana.nVars+ic] = 1.0
+				// Fill histo (and fill tree) with full slices...
+				if v.isSlice {
+					xs := getF64s[iv]()
+					for _, x := range xs {
+						h[ic][iv].Fill(x, w)
+						for j, sw := range systs {
+							hs[sw.iSyst].up[ic][iv].Fill(x, wUp[j])
+							hs[sw.iSyst].down[ic][iv].Fill(x, wDown[j])
+						}
+					}
+					if ana.DumpTree {
+						dump.Vars[iv] = xs
+						dump.VarsN[iv] = int32(len(xs))
 					}
 
-					// Otherwise, loop over variables.
-					for iv, v := range ana.Variables {
-
-						// Fill histo (and fill tree) with full slices...
-						if v.isSlice {
-							xs := getF64s[iv]()
-							for _, x := range xs {
-								h[ic][iv].Fill(x, w)
-							}
-							if ana.DumpTree {
-								dump.Vars[iv] = xs
-								dump.VarsN[iv] = int32(len(xs))
-							}
-							
-						} else {
-							// ... or the single variable value.
-							x := getF64[iv]()
-							h[ic][iv].Fill(x, w)
-							if ana.DumpTree {
-								dump.Var[iv] = x
-							}
-						}
+				} else {
+					// ... or the single variable value.
+					x := getF64[iv]()
+					h[ic][iv].Fill(x, w)
+					for j, sw := range systs {
+						hs[sw.iSyst].up[ic][iv].Fill(x, wUp[j])
+						hs[sw.iSyst].down[ic][iv].Fill(x, wDown[j])
+					}
+					if ana.DumpTree {
+						dump.Var[iv] = x
 					}
 				}
+			}
 
-				if ana.DumpTree {
-					_, err = tOut.Write()
-					if err != nil {
-						log.Fatalf("could not write event in a tree: %+v", err)
+			// Fill 2D histos, pairing slice elements by index.
+			for iv, v := range vars2D {
+				if !c.mask.variable2D(iv) {
+					continue
+				}
+				if v.isSlice {
+					xs, ys := getXYs[iv][0](), getXYs[iv][1]()
+					for i := 0; i < len(xs) && i < len(ys); i++ {
+						h2[ic][iv].Fill(xs[i], ys[i], w)
 					}
+				} else {
+					h2[ic][iv].Fill(getXY[iv][0](), getXY[iv][1](), w)
 				}
-
-				return nil
-			})
-
-			// Error check of rtree.Reader
-			if err != nil {
-				log.Fatalf("could not read tree: %+v", err)
 			}
 
-			// Keep track of the number of processed events.
-			switch ana.NevtsMax {
-			case -1:
-				ana.nEvtsSample[sampleIdx] += t.Entries()
-			default:
-				ana.nEvtsSample[sampleIdx] += ana.NevtsMax
+			// Fill profiles, pairing slice elements by index.
+			for ip, p := range profs {
+				if !c.mask.profile(ip) {
+					continue
+				}
+				if p.isSlice {
+					xs, ys := getProfXYs[ip][0](), getProfXYs[ip][1]()
+					for i := 0; i < len(xs) && i < len(ys); i++ {
+						hp[ic][ip].fill(xs[i], ys[i], w)
+					}
+				} else {
+					hp[ic][ip].fill(getProfXY[ip][0](), getProfXY[ip][1](), w)
+				}
 			}
+		}
 
-			return nil
-		}(iComp)
-	}
+		if out != nil {
+			if err := out.write(dump); err != nil {
+				return fmt.Errorf("could not write event %d in dumped tree: %w", rctx.Entry, err)
+			}
+		}
 
-	// Fill the histos for this sample
-	ana.hbookHistos[sampleIdx] = h
+		return nil
+	})
 
-	// Explicitely close file and tree
-	if ana.DumpTree {
-		if err := tOut.Close(); err != nil {
-			log.Fatalf("could not close tree: %+v", err)
-		}
-		if err := fOut.Close(); err != nil {
-			log.Fatalf("could not close root file: %+v", err)
-		}
+	// Error check of rtree.Reader
+	if err != nil {
+		return compErr("read tree", err)
 	}
 
+	return nil
 }
 
 // Helper to get a tree from a file
-func getTreeFromFile(filename, treename string) (*groot.File, rtree.Tree) {
+func getTreeFromFile(filename, treename string) (*groot.File, rtree.Tree, error) {
 
 	// Get the file
 	f, err := groot.Open(filename)
 	if err != nil {
-		log.Fatal("could not open ROOT file: %w", err)
+		return nil, nil, fmt.Errorf("could not open ROOT file: %w", err)
 	}
 
 	// Get the tree
 	obj, err := f.Get(treename)
 	if err != nil {
-		log.Fatal("could not retrieve object: %w", err)
+		f.Close()
+		return nil, nil, fmt.Errorf("could not retrieve object: %w", err)
+	}
+	t, ok := obj.(rtree.Tree)
+	if !ok {
+		f.Close()
+		return nil, nil, fmt.Errorf("object %q is not a tree (got %T)", treename, obj)
 	}
 
-	return f, obj.(rtree.Tree)
+	return f, t, nil
 }
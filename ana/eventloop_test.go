@@ -0,0 +1,67 @@
+package ana_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rmadar/tree-gonalyzer/ana"
+)
+
+// lateCtx is a context which is never done during the event
+// loops, but reports an error afterwards, as a context whose
+// deadline expires right after the last job.
+type lateCtx struct {
+	context.Context
+}
+
+func (lateCtx) Err() error { return context.DeadlineExceeded }
+
+func TestRunEventLoopsContextDoneAfterJobs(t *testing.T) {
+	samples := []*ana.Sample{
+		ana.CreateSample("proc", "bkg", `Proc`, fBkg1, tName),
+	}
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1000),
+	}
+	analyzer, err := ana.New(samples, variables, ana.WithNWorkers(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := analyzer.RunEventLoopsContext(lateCtx{context.Background()}); err != nil {
+		t.Fatalf("completed event loops reported as failed: %v", err)
+	}
+	y, err := analyzer.Yields()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := y.Values[0][0].N; got != 10000 {
+		t.Fatalf("invalid number of events: got=%d, want=10000", got)
+	}
+}
+
+func TestRunEventLoopsContextDoneAfterJobError(t *testing.T) {
+	// The first job fails to bind its cut, cancelling the second one.
+	samples := []*ana.Sample{
+		ana.CreateSample("bad", "bkg", `Bad`, fBkg1, tName,
+			ana.WithCut(ana.TreeCut("no_such_branch"))),
+		ana.CreateSample("proc", "bkg", `Proc`, fBkg1, tName),
+	}
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1000),
+	}
+	analyzer, err := ana.New(samples, variables, ana.WithNWorkers(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = analyzer.RunEventLoopsContext(lateCtx{context.Background()})
+	var (
+		ie *ana.InterruptError
+		e  *ana.Error
+	)
+	if errors.As(err, &ie) || !errors.As(err, &e) || e.Sample != "bad" {
+		t.Fatalf("job error hidden by the interruption: %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package ana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"gonum.org/v1/plot/cmpimg"
+
+	"github.com/rmadar/tree-gonalyzer/ana"
+)
+
+func init() {
+	// Functions used in testdata/analysis.yaml
+	err := ana.RegisterTreeFunc("ptWeight", ana.TreeFunc{
+		VarsName: []string{"t_pt"},
+		Fct:      func(pt float32) float64 { return 1 + float64(pt)/500 },
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = ana.RegisterTreeFunc("highTopPt", ana.TreeFunc{
+		VarsName: []string{"t_pt"},
+		Fct:      func(pt float32) bool { return pt > 100 },
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestNewFromConfig(t *testing.T) {
+	cmpimg.CheckPlot(ExampleNewFromConfig, t,
+		"Plots_fromConfig/highPt/Mttbar.png",
+		"Plots_fromConfig/highPt/DphiLL.png",
+	)
+}
+
+func ExampleNewFromConfig() {
+	// Samples, variables, selections and settings are read from
+	// the configuration file, with the functions registered above.
+	analyzer, err := ana.NewFromConfig("testdata/analysis.yaml")
+	if err != nil {
+		panic(err)
+	}
+
+	// Run the analyzer to produce all the plots
+	if err := analyzer.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func ExampleRegisterTreeFunc() {
+	err := ana.RegisterTreeFunc("ptWeight", ana.TreeFunc{})
+	fmt.Println(err)
+	fmt.Println(ana.RegisteredTreeFuncs())
+
+	// Output:
+	// tree function registry: "ptWeight" already registered
+	// [highTopPt ptWeight]
+}
@@ -0,0 +1,131 @@
+package ana_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/rmadar/tree-gonalyzer/ana"
+)
+
+func ExampleWithCutFlow() {
+	// Samples, with a data sample, a weighted
+	// background and a signal
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg", "bkg", `Bkg`, fBkg1, tName,
+			ana.WithWeight(w2), ana.WithXsec(1.5), ana.WithNgen(1000)),
+		ana.CreateSample("sig", "sig", `Signal`, fBkg2, tName,
+			ana.WithXsec(0.05), ana.WithNgen(1000)),
+	}
+
+	// Variables
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1000),
+	}
+
+	// Cuts applied one after the other
+	cuts := []*ana.Selection{
+		ana.NewSelection("qq", ana.TreeCut("init_qq")),
+		ana.NewSelection("topPt", ana.TreeExpr("t_pt > 100")),
+		ana.NewSelection("dphi", ana.TreeFunc{
+			VarsName: []string{"truth_dphi_ll"},
+			Fct:      func(dphi float64) bool { return dphi < 1.5 },
+		}),
+	}
+
+	// Fill the histograms and count the cut flow,
+	// saved in the output directory.
+	analyzer, err := ana.New(samples, variables,
+		ana.WithCutFlow(cuts),
+		ana.WithLumi(1),
+		ana.WithSavePath("testdata/CutFlow"),
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err := analyzer.RunEventLoops(); err != nil {
+		panic(err)
+	}
+
+	// Print the cut-flow table
+	cf, err := analyzer.CutFlow()
+	if err != nil {
+		panic(err)
+	}
+	cf.WriteASCII(os.Stdout)
+
+	// Output:
+	// | Cut name |              data | Abs. eff. (%) | Rel. eff. (%) |             bkg | Abs. eff. (%) | Rel. eff. (%) |           sig | Abs. eff. (%) | Rel. eff. (%) |    S/B | S/sqrt(B) |
+	// |----------|-------------------|---------------|---------------|-----------------|---------------|---------------|---------------|---------------|---------------|--------|-----------|
+	// | all      | 10000.00 ± 100.00 |   100.0 ± 0.0 |   100.0 ± 0.0 | 7500.00 ± 75.00 |   100.0 ± 0.0 |   100.0 ± 0.0 | 500.00 ± 5.00 |   100.0 ± 0.0 |   100.0 ± 0.0 | 0.0667 |    5.7735 |
+	// | qq       |   1242.00 ± 35.24 |    12.4 ± 0.3 |    12.4 ± 0.3 |  931.50 ± 26.43 |    12.4 ± 0.3 |    12.4 ± 0.3 |  60.00 ± 1.73 |    12.0 ± 0.3 |    12.0 ± 0.3 | 0.0644 |    1.9659 |
+	// | topPt    |    638.00 ± 25.26 |     6.4 ± 0.2 |    51.4 ± 1.4 |  478.50 ± 18.94 |     6.4 ± 0.2 |    51.4 ± 1.4 |  32.50 ± 1.27 |     6.5 ± 0.2 |    54.2 ± 1.4 | 0.0679 |    1.4857 |
+	// | dphi     |    177.00 ± 13.30 |     1.8 ± 0.1 |    27.7 ± 1.8 |   132.75 ± 9.98 |     1.8 ± 0.1 |    27.7 ± 1.8 |   6.95 ± 0.59 |     1.4 ± 0.1 |    21.4 ± 1.6 | 0.0524 |    0.6032 |
+}
+
+func ExampleRunCutFlows() {
+	// Samples, with a data sample, a weighted
+	// background and a signal
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg", "bkg", `Bkg`, fBkg1, tName,
+			ana.WithWeight(w2), ana.WithXsec(1.5), ana.WithNgen(1000)),
+		ana.CreateSample("sig", "sig", `Signal`, fBkg2, tName,
+			ana.WithXsec(0.05), ana.WithNgen(1000)),
+	}
+
+	// Cuts applied one after the other, as for histograms
+	cuts := []*ana.Selection{
+		ana.NewSelection("qq", ana.TreeCut("init_qq")),
+		ana.NewSelection("topPt", ana.TreeExpr("t_pt > 100")),
+		ana.NewSelection("dphi", ana.TreeFunc{
+			VarsName: []string{"truth_dphi_ll"},
+			Fct:      func(dphi float64) bool { return dphi < 1.5 },
+		}),
+	}
+
+	// Run the cut flows, without histograms
+	cf, err := ana.RunCutFlows(samples, cuts, ana.WithLumi(1))
+	if err != nil {
+		panic(err)
+	}
+
+	// Print the cut flows side by side
+	cf.WriteASCII(os.Stdout)
+
+	// Output:
+	// | Cut name |              data | Abs. eff. (%) | Rel. eff. (%) |             bkg | Abs. eff. (%) | Rel. eff. (%) |           sig | Abs. eff. (%) | Rel. eff. (%) |    S/B | S/sqrt(B) |
+	// |----------|-------------------|---------------|---------------|-----------------|---------------|---------------|---------------|---------------|---------------|--------|-----------|
+	// | all      | 10000.00 ± 100.00 |   100.0 ± 0.0 |   100.0 ± 0.0 | 7500.00 ± 75.00 |   100.0 ± 0.0 |   100.0 ± 0.0 | 500.00 ± 5.00 |   100.0 ± 0.0 |   100.0 ± 0.0 | 0.0667 |    5.7735 |
+	// | qq       |   1242.00 ± 35.24 |    12.4 ± 0.3 |    12.4 ± 0.3 |  931.50 ± 26.43 |    12.4 ± 0.3 |    12.4 ± 0.3 |  60.00 ± 1.73 |    12.0 ± 0.3 |    12.0 ± 0.3 | 0.0644 |    1.9659 |
+	// | topPt    |    638.00 ± 25.26 |     6.4 ± 0.2 |    51.4 ± 1.4 |  478.50 ± 18.94 |     6.4 ± 0.2 |    51.4 ± 1.4 |  32.50 ± 1.27 |     6.5 ± 0.2 |    54.2 ± 1.4 | 0.0679 |    1.4857 |
+	// | dphi     |    177.00 ± 13.30 |     1.8 ± 0.1 |    27.7 ± 1.8 |   132.75 ± 9.98 |     1.8 ± 0.1 |    27.7 ± 1.8 |   6.95 ± 0.59 |     1.4 ± 0.1 |    21.4 ± 1.6 | 0.0524 |    0.6032 |
+}
+
+// The cut-flow tables of RunCutFlows are never saved,
+// even with WithSaveYields which saves the yield tables.
+func TestRunCutFlowsSave(t *testing.T) {
+	const path = "testdata/RunCutFlows_save"
+	os.RemoveAll(path)
+	defer os.RemoveAll(path)
+
+	samples := []*ana.Sample{ana.CreateSample("bkg", "bkg", `Bkg`, fBkg1, tName)}
+	cuts := []*ana.Selection{ana.NewSelection("qq", ana.TreeCut("init_qq"))}
+
+	_, err := ana.RunCutFlows(samples, cuts,
+		ana.WithSavePath(path),
+		ana.WithSaveYields(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ext := range []string{".txt", ".md", ".tex", ".csv"} {
+		if _, err := os.Stat(path + "/yields" + ext); err != nil {
+			t.Errorf("yield table not saved: %v", err)
+		}
+		if _, err := os.Stat(path + "/cutflow" + ext); err == nil {
+			t.Errorf("cut-flow table cutflow%s saved", ext)
+		}
+	}
+}
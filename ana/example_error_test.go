@@ -0,0 +1,102 @@
+package ana_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rmadar/tree-gonalyzer/ana"
+)
+
+func ExampleError() {
+	// Sample with an unknown type
+	samples := []*ana.Sample{
+		ana.CreateSample("proc", "mc", `Proc`, fBkg1, tName),
+	}
+
+	// The error is reported when creating the analysis maker
+	_, err := ana.New(samples, []*ana.Variable{})
+	fmt.Println(err)
+
+	// The original error can be checked
+	fmt.Println(errors.Is(err, ana.ErrSampleType))
+
+	// The context of the error can be retrieved
+	var e *ana.Error
+	if errors.As(err, &e) {
+		fmt.Println(e.Sample)
+	}
+
+	// Output:
+	// ana: create sample [sample="proc"]: sample type not supported: "mc"
+	// true
+	// proc
+}
+
+func ExampleError_variable() {
+	// Variable defined from a missing branch
+	samples := []*ana.Sample{
+		ana.CreateSample("proc", "bkg", `Proc`, fBkg1, tName),
+	}
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m_missing"), 25, 350, 1000),
+	}
+
+	// The failing variable is reported
+	_, err := ana.New(samples, variables)
+	var e *ana.Error
+	if errors.As(err, &e) {
+		fmt.Println(e.Op, e.Sample, e.Variable)
+	}
+
+	// Output:
+	// assess variable type proc Mttbar
+}
+
+func ExampleError_selection() {
+	// Selection named as the one without name
+	samples := []*ana.Sample{
+		ana.CreateSample("proc", "bkg", `Proc`, fBkg1, tName),
+	}
+	selections := []*ana.Selection{
+		ana.EmptySelection(),
+		ana.NewSelection("all", ana.TreeCut("init_qq")),
+	}
+
+	// Their histograms and yields would be mixed up
+	_, err := ana.New(samples, []*ana.Variable{}, ana.WithKinemCuts(selections))
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ana.ErrSelectionName))
+
+	// Output:
+	// ana: check selections [selection="all"]: reserved selection name: "all" is used for the selection without name
+	// true
+}
+
+func ExampleInterruptError() {
+	// Samples and variables
+	samples := []*ana.Sample{
+		ana.CreateSample("proc", "bkg", `Proc`, fBkg1, tName),
+	}
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1000),
+	}
+	analyzer, err := ana.New(samples, variables)
+	if err != nil {
+		panic(err)
+	}
+
+	// Context cancelled before running, e.g. after a SIGTERM.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Event loops are interrupted, reporting processed events.
+	err = analyzer.RunContext(ctx)
+	var e *ana.InterruptError
+	if errors.As(err, &e) {
+		fmt.Println(e.NEvents, errors.Is(err, context.Canceled))
+	}
+
+	// Output:
+	// 0 true
+}
@@ -0,0 +1,80 @@
+package ana_test
+
+import (
+	"fmt"
+
+	"go-hep.org/x/hep/groot"
+	"go-hep.org/x/hep/groot/rhist"
+	"go-hep.org/x/hep/groot/riofs"
+
+	"github.com/rmadar/tree-gonalyzer/ana"
+)
+
+func ExampleWithSaveHistos() {
+	// Samples, the background having a systematic variation
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg", "bkg", `Bkg`, fBkg2, tName,
+			ana.WithSystematic("norm", ana.TreeValF64(1.1), ana.TreeValF64(0.9)),
+		),
+	}
+
+	// Variables
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1000),
+	}
+
+	// Selections
+	selections := []*ana.Selection{
+		ana.EmptySelection(),
+		ana.NewSelection("highPt", ana.TreeFunc{
+			VarsName: []string{"t_pt"},
+			Fct:      func(pt float32) bool { return pt > 100 },
+		}),
+	}
+
+	// Fill the histograms and save them
+	analyzer, err := ana.New(samples, variables,
+		ana.WithKinemCuts(selections),
+		ana.WithSavePath("testdata/Histos_withSaveHistos"),
+		ana.WithSaveHistos("histos.root"),
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err := analyzer.RunEventLoops(); err != nil {
+		panic(err)
+	}
+
+	// Read them back
+	f, err := groot.Open("testdata/Histos_withSaveHistos/histos.root")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	for _, dir := range []string{"all", "highPt"} {
+		obj, err := riofs.Dir(f).Get(dir)
+		if err != nil {
+			panic(err)
+		}
+		for _, k := range obj.(riofs.Directory).Keys() {
+			obj, err := k.Object()
+			if err != nil {
+				panic(err)
+			}
+			h := obj.(rhist.H1)
+			fmt.Printf("%s/%s: %s, %v entries, sumw=%.1f\n", dir, k.Name(), k.ClassName(), h.Entries(), h.SumW())
+		}
+	}
+
+	// Output:
+	// all/data__Mttbar: TH1D, 10000 entries, sumw=10000.0
+	// all/bkg__Mttbar: TH1D, 10000 entries, sumw=10000.0
+	// all/bkg__Mttbar__normUp: TH1D, 10000 entries, sumw=11000.0
+	// all/bkg__Mttbar__normDown: TH1D, 10000 entries, sumw=9000.0
+	// highPt/data__Mttbar: TH1D, 5348 entries, sumw=5348.0
+	// highPt/bkg__Mttbar: TH1D, 5505 entries, sumw=5505.0
+	// highPt/bkg__Mttbar__normUp: TH1D, 5505 entries, sumw=6055.5
+	// highPt/bkg__Mttbar__normDown: TH1D, 5505 entries, sumw=4954.5
+}
@@ -3,6 +3,7 @@ package ana_test
 import (
 	"image/color"
 	"math"
+	"os"
 	"testing"
 
 	"golang.org/x/exp/rand"
@@ -49,6 +50,65 @@ func TestShapeDistortion(t *testing.T) {
 	)
 }
 
+func TestSystematics(t *testing.T) {
+	cmpimg.CheckPlot(Example_withSystematics, t,
+		"Plots_withSystematics/Mttbar.png",
+	)
+}
+
+func TestBranchSystematics(t *testing.T) {
+	cmpimg.CheckPlot(Example_withBranchSystematics, t,
+		"Plots_withBranchSystematics/central/LepPt.png",
+	)
+}
+
+func TestFromHistos(t *testing.T) {
+	cmpimg.CheckPlot(Example_fromHistos, t,
+		"Plots_fromHistos/Mttbar.png",
+	)
+}
+
+func TestCache(t *testing.T) {
+	cmpimg.CheckPlot(Example_withCache, t,
+		"Plots_withCache/Mttbar.png",
+		"Plots_withCache/TopPt.png",
+	)
+}
+
+func TestNWorkers(t *testing.T) {
+	cmpimg.CheckPlot(Example_withNWorkers, t,
+		"Plots_withNWorkers/Mttbar.png",
+		"Plots_withNWorkers/DphiLL.png",
+	)
+}
+
+func TestVariables2D(t *testing.T) {
+	cmpimg.CheckPlot(Example_withVariables2D, t,
+		"Plots_withVariables2D/MttDphi_data.png",
+		"Plots_withVariables2D/MttDphi_bkg.png",
+		"Plots_withVariables2D/MttDphi_ratio.png",
+	)
+}
+
+func TestProfiles(t *testing.T) {
+	cmpimg.CheckPlot(Example_withProfiles, t,
+		"Plots_withProfiles/TopPtVsMtt.png",
+	)
+}
+
+func TestBinEdges(t *testing.T) {
+	cmpimg.CheckPlot(Example_withBinEdges, t,
+		"Plots_withBinEdges/Mttbar.png",
+	)
+}
+
+func TestFlowMode(t *testing.T) {
+	cmpimg.CheckPlot(Example_withFlowMode, t,
+		"Plots_withFlowMode/TopPt.png",
+		"Plots_withFlowMode/Mttbar.png",
+	)
+}
+
 func TestSliceVariables(t *testing.T) {
 	cmpimg.CheckPlot(Example_withSliceVariables, t,
 		"Plots_withSliceVariables/hitTimes.png",
@@ -114,9 +174,12 @@ func Example_aSimpleUseCase() {
 	}
 
 	// Create analyzer object
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithSavePath("testdata/Plots_simpleUseCase"),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -147,10 +210,13 @@ func Example_withLogScale() {
 	}
 
 	// Create analyzer object
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithSavePath("testdata/Plots_LogScale"),
 		ana.WithHistoStack(true),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -196,9 +262,12 @@ func Example_withSignals() {
 	}
 
 	// Create analyzer object
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithSavePath("testdata/Plots_withSignals"),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -235,10 +304,13 @@ func Example_withSignalsStacked() {
 	}
 
 	// Create analyzer object
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithSignalStack(true),
 		ana.WithSavePath("testdata/Plots_withStackedSignals"),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -280,10 +352,13 @@ func Example_multiComponentSamples() {
 	}
 
 	// Create analyzer object with normalized histograms.
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithHistoNorm(true),
 		ana.WithSavePath("testdata/Plots_multiComponents"),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -317,13 +392,16 @@ func Example_shapeComparison() {
 	}
 
 	// Create analyzer object
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithNevtsMax(500),
 		ana.WithHistoStack(false),
 		ana.WithHistoNorm(true),
 		ana.WithRatioPlot(false),
 		ana.WithSavePath("testdata/Plots_shapeComparison"),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -366,12 +444,252 @@ func Example_systematicVariations() {
 	}
 
 	// Create analyzer object
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithRatioPlot(true),
 		ana.WithHistoStack(false),
 		ana.WithHistoNorm(true),
 		ana.WithSavePath("testdata/Plots_systVariations"),
 	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Run the analyzer to produce all the plots
+	if err := analyzer.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func Example_withSystematics() {
+	// Weight factors of a top pT modelling uncertainty
+	ptUp := ana.TreeFunc{
+		VarsName: []string{"t_pt"},
+		Fct:      func(pt float32) float64 { return 1.0 + float64(pt)/1000. },
+	}
+	ptDown := ana.TreeFunc{
+		VarsName: []string{"t_pt"},
+		Fct:      func(pt float32) float64 { return 1.0 - float64(pt)/1000. },
+	}
+
+	// Samples: the top pT uncertainty affects the first background,
+	// while a normalization uncertainty is given to the component
+	// of the second one.
+	bkg2 := ana.NewSample("bkg2", "bkg", `Proc 2`, ana.WithWeight(w2))
+	bkg2.AddComponent(fBkg2, tName,
+		ana.WithSystematic("norm", ana.TreeValF64(1.1), ana.TreeValF64(0.9)),
+	)
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg1", "bkg", `Proc 1`, fBkg1, tName,
+			ana.WithWeight(w2),
+			ana.WithSystematic("topPt", ptUp, ptDown),
+		),
+		bkg2,
+	}
+
+	// Define variables
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1500,
+			ana.WithAxisLabels("M(t,t) [GeV]", "Events"),
+			ana.WithRatioYRange(0.7, 1.3),
+		),
+	}
+
+	// Create analyzer object: the total band shows the
+	// statistical and systematic uncertainties.
+	analyzer, err := ana.New(samples, variables,
+		ana.WithSavePath("testdata/Plots_withSystematics"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Run the analyzer to produce all the plots
+	if err := analyzer.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func Example_withBranchSystematics() {
+	// Samples: the lepton of the first background is varied by
+	// reading the anti-lepton branches instead, while the down
+	// variation keeps the nominal branches.
+	lepUp := map[string]string{"l_pt": "lbar_pt", "l_eta": "lbar_eta"}
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg1", "bkg", `Proc 1`, fBkg1, tName,
+			ana.WithWeight(w2),
+			ana.WithBranchSystematic("lepton", lepUp, nil),
+		),
+		ana.CreateSample("bkg2", "bkg", `Proc 2`, fBkg2, tName,
+			ana.WithWeight(w2),
+		),
+	}
+
+	// Define variables
+	variables := []*ana.Variable{
+		ana.NewVariable("LepPt", ana.TreeVarF32("l_pt"), 25, 0, 250,
+			ana.WithAxisLabels("Lepton pT [GeV]", "Events"),
+			ana.WithRatioYRange(0.7, 1.3),
+		),
+	}
+
+	// Define a selection on the lepton, which is varied
+	// together with the variable.
+	selections := []*ana.Selection{
+		ana.NewSelection("central", ana.TreeFunc{
+			VarsName: []string{"l_eta"},
+			Fct:      func(eta float32) bool { return eta > -2.5 && eta < 2.5 },
+		}),
+	}
+
+	// Create analyzer object
+	analyzer, err := ana.New(samples, variables,
+		ana.WithKinemCuts(selections),
+		ana.WithSavePath("testdata/Plots_withBranchSystematics"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Run the analyzer to produce all the plots
+	if err := analyzer.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func Example_fromHistos() {
+	// Samples
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg1", "bkg", `Proc 1`, fBkg1, tName, ana.WithWeight(w2)),
+		ana.CreateSample("bkg2", "bkg", `Proc 2`, fBkg2, tName, ana.WithWeight(w2)),
+	}
+
+	// Fill histograms with a fine binning, and save them
+	// without plotting.
+	fine := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 100, 300, 1800),
+	}
+	filler, err := ana.New(samples, fine,
+		ana.WithSavePath("testdata/Plots_fromHistos"),
+		ana.WithSaveHistos("histos.root"),
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err := filler.RunEventLoops(); err != nil {
+		panic(err)
+	}
+
+	// Plot them later on, with a coarser binning and
+	// another style, without running the event loops.
+	coarse := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 0, 0, 0,
+			ana.WithBinEdges([]float64{345, 405, 450, 495, 555, 645, 780, 1005, 1500}),
+			ana.WithAxisLabels("M(t,t) [GeV]", ""),
+			ana.WithRatioYRange(0.7, 1.3),
+		),
+	}
+	analyzer, err := ana.NewFromHistos(samples, coarse,
+		"testdata/Plots_fromHistos/histos.root",
+		ana.WithSavePath("testdata/Plots_fromHistos"),
+		ana.WithTotalBandColor(color.NRGBA{R: 200, G: 50, B: 50, A: 100}),
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err := analyzer.PlotVariables(); err != nil {
+		panic(err)
+	}
+}
+
+func Example_withCache() {
+	// Start from an empty cache
+	os.RemoveAll("testdata/Plots_withCache/.cache")
+
+	// Samples
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg1", "bkg", `Proc 1`, fBkg1, tName, ana.WithWeight(w2)),
+		ana.CreateSample("bkg2", "bkg", `Proc 2`, fBkg2, tName, ana.WithWeight(w2)),
+	}
+
+	// Variables
+	mtt := ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1500,
+		ana.WithAxisLabels("M(t,t) [GeV]", "Events"),
+		ana.WithRatioYRange(0.7, 1.3),
+	)
+	pt := ana.NewVariable("TopPt", ana.TreeVarF32("t_pt"), 20, 0, 500,
+		ana.WithAxisLabels("pT(t) [GeV]", "Events"),
+		ana.WithRatioYRange(0.7, 1.3),
+	)
+
+	// The first run fills the cache with the mass histograms,
+	// the second one only reads the top pT, the mass being
+	// taken from the cache.
+	for _, variables := range [][]*ana.Variable{{mtt}, {mtt, pt}} {
+		analyzer, err := ana.New(samples, variables,
+			ana.WithSavePath("testdata/Plots_withCache"),
+			ana.WithCache(true),
+		)
+		if err != nil {
+			panic(err)
+		}
+		if err := analyzer.Run(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func Example_withNWorkers() {
+	// Samples, the first one being split in several
+	// components.
+	nom := ana.CreateSample("nom", "bkg", `Nominal`, fBkg1, tName,
+		ana.WithLineColor(softBlack),
+		ana.WithLineWidth(2.0),
+		ana.WithBand(true),
+	)
+	nom.AddComponent(fBkg1, tName)
+	samples := []*ana.Sample{
+		nom,
+		ana.CreateSample("up", "bkg", `Up`, fBkg1, tName,
+			ana.WithWeight(w3),
+			ana.WithLineColor(darkRed),
+			ana.WithLineWidth(1.5),
+			ana.WithLineDashes([]vg.Length{3, 2}),
+		),
+		ana.CreateSample("down", "bkg", `Down`, fBkg1, tName,
+			ana.WithWeight(w4),
+			ana.WithLineColor(darkBlue),
+			ana.WithLineWidth(1.5),
+			ana.WithLineDashes([]vg.Length{3, 2}),
+		),
+	}
+
+	// Define variables
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1500,
+			ana.WithRatioYRange(0.7, 1.3)),
+		ana.NewVariable("DphiLL", ana.TreeVarF64("truth_dphi_ll"), 10, 0, math.Pi,
+			ana.WithRatioYRange(0.7, 1.3),
+			ana.WithYRange(0, 0.2),
+			ana.WithLegLeft(true),
+		),
+	}
+
+	// Create analyzer object, processing the events
+	// by ranges of entries over 4 concurrent workers.
+	analyzer, err := ana.New(samples, variables,
+		ana.WithNWorkers(4),
+		ana.WithRatioPlot(true),
+		ana.WithHistoStack(false),
+		ana.WithHistoNorm(true),
+		ana.WithSavePath("testdata/Plots_withNWorkers"),
+	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -423,13 +741,16 @@ func Example_shapeDistortion() {
 	}
 
 	// Create analyzer object
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithAutoStyle(false),
 		ana.WithHistoStack(false),
 		ana.WithRatioPlot(false),
 		ana.WithHistoNorm(true),
 		ana.WithSavePath("testdata/Plots_shapeDistortion"),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -478,11 +799,14 @@ func Example_withTreeDumping() {
 	}
 
 	// Create analyzer object with normalized histograms.
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithKinemCuts(selections),
 		ana.WithDumpTree(true),
 		ana.WithSavePath("testdata/Plots_withTreeDumping"),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -515,11 +839,14 @@ func Example_produceTreesNewVariables() {
 	variables = append(variables, ana.NewVariable("smearMttbar", smearedMtt, 0, 0, 0))
 
 	// Create analyzer object with normalized histograms.
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithDumpTree(true),
 		ana.WithPlotHisto(false),
 		ana.WithSavePath("testdata/Plots_produceTreeNewVar"),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer and dump on tree per sample
 	if err := analyzer.Run(); err != nil {
@@ -529,7 +856,7 @@ func Example_produceTreesNewVariables() {
 	// Read back 'data.root' and plot the two variables.
 	newFilePath := "testdata/Plots_produceTreeNewVar/ntuples/data.root"
 	newTreeName := "GOtree"
-	plotter := ana.New(
+	plotter, err := ana.New(
 		[]*ana.Sample{
 			ana.CreateSample("new", "bkg", `new ntuple`, newFilePath, newTreeName),
 		},
@@ -545,6 +872,9 @@ func Example_produceTreesNewVariables() {
 		},
 		ana.WithSavePath("testdata/Plots_produceTreeNewVar"),
 	)
+	if err != nil {
+		panic(err)
+	}
 	if err := plotter.Run(); err != nil {
 		panic(err)
 	}
@@ -566,11 +896,167 @@ func Example_withSliceVariables() {
 	}
 
 	// Analyzer
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithHistoStack(false),
 		ana.WithRatioPlot(false),
 		ana.WithSavePath("testdata/Plots_withSliceVariables"),
 	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Run the analyzer to produce all the plots
+	if err := analyzer.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func Example_withVariables2D() {
+	// Samples
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg", "bkg", `Simulation`, fBkg2, tName,
+			ana.WithWeight(w4),
+		),
+	}
+
+	// Two-dimensional variables
+	variables2D := []*ana.Variable2D{
+		ana.NewVariable2D("MttDphi",
+			ana.TreeVarF32("ttbar_m"), ana.TreeVarF64("truth_dphi_ll"),
+			20, 350, 1000, 10, 0, math.Pi,
+			ana.WithAxisLabels("M(t,t) [GeV]", "dPhi(l,l)"),
+			ana.WithRatioZRange(0.5, 1.5),
+		),
+	}
+
+	// Analyzer with only two-dimensional variables
+	analyzer, err := ana.New(samples, []*ana.Variable{},
+		ana.WithVariables2D(variables2D),
+		ana.WithHistoNorm(true),
+		ana.WithSavePath("testdata/Plots_withVariables2D"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Run the analyzer to produce all the plots
+	if err := analyzer.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func Example_withProfiles() {
+	// Samples
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg1", "bkg", `Simulation A`, fBkg1, tName,
+			ana.WithWeight(w3),
+		),
+		ana.CreateSample("bkg2", "bkg", `Simulation B`, fBkg2, tName),
+	}
+
+	// Mean top pT versus ttbar mass
+	profiles := []*ana.Profile{
+		ana.NewProfile("TopPtVsMtt",
+			ana.TreeVarF32("ttbar_m"), ana.TreeVarF32("t_pt"),
+			13, 350, 1000,
+			ana.WithAxisLabels("M(t,t) [GeV]", "Mean pT(t) [GeV]"),
+			ana.WithRatioYRange(0.6, 1.4),
+			ana.WithLegLeft(true),
+		),
+	}
+
+	// Analyzer with only profiles
+	analyzer, err := ana.New(samples, []*ana.Variable{},
+		ana.WithProfiles(profiles),
+		ana.WithHistoStack(false),
+		ana.WithSavePath("testdata/Plots_withProfiles"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Run the analyzer to produce all the plots
+	if err := analyzer.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func Example_withBinEdges() {
+	// Samples
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg1", "bkg", `Proc 1`, fBkg1, tName,
+			ana.WithWeight(w1),
+		),
+		ana.CreateSample("bkg2", "bkg", `Proc 2`, fBkg2, tName),
+	}
+
+	// Variable with bins of increasing width: the plotted
+	// histograms are divided by the bin width.
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 0, 0, 0,
+			ana.WithBinEdges([]float64{350, 400, 450, 500, 550, 600, 700, 800, 1000, 1300}),
+			ana.WithAxisLabels("M(t,t) [GeV]", ""),
+			ana.WithLogY(true),
+		),
+	}
+
+	// Create analyzer object
+	analyzer, err := ana.New(samples, variables,
+		ana.WithSavePath("testdata/Plots_withBinEdges"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Run the analyzer to produce all the plots
+	if err := analyzer.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func Example_withFlowMode() {
+	// Samples
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("proc1", "bkg", `Simulation A`, fBkg1, tName,
+			ana.WithLineColor(darkBlue),
+			ana.WithLineWidth(2),
+			ana.WithBand(true),
+		),
+		ana.CreateSample("proc2", "bkg", `Simulation B`, fBkg2, tName,
+			ana.WithLineColor(darkRed),
+			ana.WithLineWidth(2),
+			ana.WithBand(true),
+		),
+	}
+
+	// Variables with a range smaller than their spectrum: the
+	// overflow of the second one is shown in an extra bin.
+	variables := []*ana.Variable{
+		ana.NewVariable("TopPt", ana.TreeVarF32("t_pt"), 10, 0, 300,
+			ana.WithAxisLabels("pT(t) [GeV]", "PDF"),
+		),
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 10, 400, 800,
+			ana.WithAxisLabels("M(t,t) [GeV]", "PDF"),
+			ana.WithVarFlowMode(ana.FlowShow),
+		),
+	}
+
+	// Create analyzer object, folding the under/overflows
+	// into the edge bins by default. Normalized shapes have
+	// a unit area over the plotted range.
+	analyzer, err := ana.New(samples, variables,
+		ana.WithFlowMode(ana.FlowFold),
+		ana.WithHistoStack(false),
+		ana.WithHistoNorm(true),
+		ana.WithSavePath("testdata/Plots_withFlowMode"),
+	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
@@ -621,11 +1107,14 @@ func Example_withJointTrees() {
 	}
 
 	// Analyzer
-	analyzer := ana.New(samples, variables,
+	analyzer, err := ana.New(samples, variables,
 		ana.WithHistoStack(false),
 		ana.WithRatioPlot(false),
 		ana.WithSavePath("testdata/Plots_withJointTrees"),
 	)
+	if err != nil {
+		panic(err)
+	}
 
 	// Run the analyzer to produce all the plots
 	if err := analyzer.Run(); err != nil {
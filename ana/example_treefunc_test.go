@@ -2,10 +2,16 @@ package ana_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
 
 	"go-hep.org/x/hep/groot"
 	"go-hep.org/x/hep/groot/rtree"
+	"go-hep.org/x/hep/groot/rtree/rfunc"
 
 	"github.com/rmadar/tree-gonalyzer/ana"
 )
@@ -237,6 +243,238 @@ func ExampleTreeFunc_withNumericalValue() {
 	// 4 0.33
 }
 
+func ExampleTreeExpr() {
+	// Get a reader for the example
+	f, r := getReaderFile("../testdata/file2.root", "truth", 5)
+	defer f.Close()
+	defer r.Close()
+
+	// Expressions computing a variable and a cut
+	pt := ana.TreeExpr("sqrt(t_pt*t_pt + tbar_pt*tbar_pt)")
+	cut := ana.TreeExpr("t_pt > 100 && abs(l_eta) < 1")
+
+	// Go functions to be called in the event loop
+	getPt, ok := pt.GetFuncF64(r)
+	if !ok {
+		log.Fatal("type assertion failed: expect float64")
+	}
+	passCut, ok := cut.GetFuncBool(r)
+	if !ok {
+		log.Fatal("type assertion failed: expect bool")
+	}
+
+	// Event loop
+	r.Read(func(ctx rtree.RCtx) error {
+		fmt.Printf("%v %.2f %v\n", ctx.Entry, getPt(), passCut())
+		return nil
+	})
+
+	// Output:
+	// 0 126.04 false
+	// 1 235.97 false
+	// 2 161.23 true
+	// 3 89.91 false
+	// 4 151.99 false
+}
+
+func ExampleTreeVar() {
+	// Get a reader for the example
+	f, r := getReaderFile("../testdata/file2.root", "truth", 5)
+	defer f.Close()
+	defer r.Close()
+
+	// Branches of type float32, int32, bool and [3]float64,
+	// and a cut on a boolean branch.
+	pt, pid, qq, kvec := ana.TreeVar("t_pt"), ana.TreeVar("t_pid"), ana.TreeVar("init_qq"), ana.TreeVar("truth_kvec")
+	cut := ana.TreeCut("init_qq")
+
+	// Go functions to be called in the event loop
+	getPt, _ := pt.GetFuncF64(r)
+	getPid, _ := pid.GetFuncF64(r)
+	getQQ, _ := qq.GetFuncF64(r)
+	getKvec, ok := kvec.GetFuncF64s(r)
+	if !ok {
+		log.Fatal("type assertion failed: expect []float64")
+	}
+	passCut, ok := cut.GetFuncBool(r)
+	if !ok {
+		log.Fatal("type assertion failed: expect bool")
+	}
+
+	// Event loop
+	r.Read(func(ctx rtree.RCtx) error {
+		fmt.Printf("%v %.2f %v %v %.2f %v\n", ctx.Entry, getPt(), getPid(), getQQ(), getKvec(), passCut())
+		return nil
+	})
+
+	// Output:
+	// 0 89.13 6 0 [-0.88 0.31 -0.36] false
+	// 1 166.86 6 0 [-0.90 0.13 0.41] false
+	// 2 114.00 6 0 [0.97 0.02 0.25] false
+	// 3 63.57 6 1 [0.44 0.35 -0.83] true
+	// 4 107.47 6 1 [0.59 -0.56 0.58] true
+}
+
+func ExampleTreeAt() {
+	// Get a reader for the example
+	f, r := getReaderFile("../testdata/file2.root", "truth", 5)
+	defer f.Close()
+	defer r.Close()
+
+	// Leading element, multiplicity, sum, minimum and maximum of
+	// a [3]float64 array, and the element of an array ordered by
+	// the values of another one.
+	first, n, sum := ana.TreeAt("truth_kvec", 0, -10), ana.TreeLen("truth_kvec"), ana.TreeSum("truth_kvec")
+	min, max := ana.TreeMin("truth_kvec", -10), ana.TreeMax("truth_kvec", -10)
+	lead := ana.TreeAtSortedBy("truth_kvec", "truth_rvec", 0, -10)
+
+	// Go functions to be called in the event loop
+	getFirst, _ := first.GetFuncF64(r)
+	getN, _ := n.GetFuncF64(r)
+	getSum, _ := sum.GetFuncF64(r)
+	getMin, _ := min.GetFuncF64(r)
+	getMax, _ := max.GetFuncF64(r)
+	getLead, _ := lead.GetFuncF64(r)
+
+	// Event loop
+	r.Read(func(ctx rtree.RCtx) error {
+		fmt.Printf("%v %.2f %v %.2f %.2f %.2f %.2f\n", ctx.Entry, getFirst(), getN(), getSum(), getMin(), getMax(), getLead())
+		return nil
+	})
+
+	// Output:
+	// 0 -0.88 3 -0.93 -0.88 0.31 -0.88
+	// 1 -0.90 3 -0.37 -0.90 0.41 0.41
+	// 2 0.97 3 1.24 0.02 0.97 0.25
+	// 3 0.44 3 -0.03 -0.83 0.44 0.35
+	// 4 0.59 3 0.61 -0.56 0.59 0.58
+}
+
+// The slice helpers are not listed as slow TreeFunc's,
+// unlike functions without fast formula.
+func TestPrintSlowTreeFuncs(t *testing.T) {
+	samples := []*ana.Sample{ana.CreateSample("bkg", "bkg", `Bkg`, fBkg1, tName)}
+	variables := []*ana.Variable{
+		ana.NewVariable("first", ana.TreeAt("truth_kvec", 0, -10), 10, -1, 1),
+		ana.NewVariable("n", ana.TreeLen("truth_kvec"), 5, 0, 5),
+		ana.NewVariable("sum", ana.TreeSum("truth_kvec"), 10, -3, 3),
+		ana.NewVariable("min", ana.TreeMin("truth_kvec", -10), 10, -1, 1),
+		ana.NewVariable("max", ana.TreeMax("truth_kvec", -10), 10, -1, 1),
+		ana.NewVariable("lead", ana.TreeAtSortedBy("truth_kvec", "truth_rvec", 0, -10), 10, -1, 1),
+	}
+	selections := []*ana.Selection{
+		ana.NewSelection("slow", ana.TreeFunc{
+			VarsName: []string{"t_pt", "tbar_pt", "l_pt", "lbar_pt", "ttbar_pt"},
+			Fct:      func(a, b, c, d, e float32) bool { return a+b+c+d+e > 0 },
+		}),
+	}
+	analyzer, err := ana.New(samples, variables, ana.WithKinemCuts(selections))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Capture the standard output
+	stdout := os.Stdout
+	rp, wp, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = wp
+	analyzer.PrintSlowTreeFuncs()
+	os.Stdout = stdout
+	wp.Close()
+	out, err := ioutil.ReadAll(rp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var listed []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "- ") {
+			listed = append(listed, strings.TrimSpace(line))
+		}
+	}
+	want := []string{"- func(float32, float32, float32, float32, float32) bool --> args = [t_pt tbar_pt l_pt lbar_pt ttbar_pt]"}
+	if !reflect.DeepEqual(listed, want) {
+		t.Fatalf("invalid slow TreeFuncs:\ngot= %q\nwant=%q", listed, want)
+	}
+}
+
+// Fast formula of the functions summing five float32.
+type sumF32x5 struct {
+	rvars []string
+	x     [5]*float32
+	fct   func(x1, x2, x3, x4, x5 float32) float64
+}
+
+func newSumF32x5(rvars []string, fct interface{}) (rfunc.Formula, error) {
+	return &sumF32x5{rvars: rvars, fct: fct.(func(x1, x2, x3, x4, x5 float32) float64)}, nil
+}
+
+func (f *sumF32x5) RVars() []string { return f.rvars }
+
+func (f *sumF32x5) Bind(args []interface{}) error {
+	if len(args) != len(f.x) {
+		return fmt.Errorf("invalid number of arguments: %d", len(args))
+	}
+	for i := range f.x {
+		f.x[i] = args[i].(*float32)
+	}
+	return nil
+}
+
+func (f *sumF32x5) Func() interface{} {
+	return func() float64 {
+		return f.fct(*f.x[0], *f.x[1], *f.x[2], *f.x[3], *f.x[4])
+	}
+}
+
+func init() {
+	// Register the fast formula, before any TreeFunc is bound.
+	proto := (func(x1, x2, x3, x4, x5 float32) float64)(nil)
+	if err := ana.RegisterFormula(proto, newSumF32x5); err != nil {
+		panic(err)
+	}
+}
+
+func ExampleRegisterFormula() {
+	// Get a reader for the example
+	f, r := getReaderFile("../testdata/file2.root", "truth", 3)
+	defer f.Close()
+	defer r.Close()
+
+	// TreeFunc with five arguments, using the fast
+	// formula registered in init().
+	ht := ana.TreeFunc{
+		VarsName: []string{"t_pt", "tbar_pt", "l_pt", "lbar_pt", "ttbar_pt"},
+		Fct: func(tpt, tbarpt, lpt, lbarpt, ttpt float32) float64 {
+			return float64(tpt + tbarpt + lpt + lbarpt + ttpt)
+		},
+	}
+	fmt.Println("slow:", ht.IsSlow())
+
+	// Types with a fast formula cannot be registered twice.
+	err := ana.RegisterFormula((func(float32) float64)(nil), newSumF32x5)
+	fmt.Println(err)
+
+	// Event loop
+	getHt, ok := ht.GetFuncF64(r)
+	if !ok {
+		log.Fatal("type assertion failed: expect float64")
+	}
+	r.Read(func(ctx rtree.RCtx) error {
+		fmt.Printf("%v %.2f\n", ctx.Entry, getHt())
+		return nil
+	})
+
+	// Output:
+	// slow: false
+	// tree function registry: func(float32) float64 has already a formula
+	// 0 319.97
+	// 1 497.95
+	// 2 319.51
+}
+
 // Helper function get a reader (w/o slices) for the examples
 func getReader(nmax int64) (*groot.File, *rtree.Reader) {
 	return getReaderFile("../testdata/file1.root", "truth", nmax)
@@ -0,0 +1,246 @@
+package ana_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/rmadar/tree-gonalyzer/ana"
+	"github.com/rmadar/tree-gonalyzer/cflow"
+)
+
+func TestYieldsWithCache(t *testing.T) {
+	const path = "testdata/Yields_withCache"
+	os.RemoveAll(path)
+	defer os.RemoveAll(path)
+
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg", "bkg", `Bkg`, fBkg2, tName, ana.WithWeight(w2)),
+	}
+	mtt := ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1000)
+	pt := ana.NewVariable("TopPt", ana.TreeVarF32("t_pt"), 20, 0, 500)
+	selections := []*ana.Selection{
+		ana.EmptySelection(),
+		ana.NewSelection("qq", ana.TreeCut("init_qq")),
+	}
+
+	cuts := []*ana.Selection{
+		ana.NewSelection("qq", ana.TreeCut("init_qq")),
+		ana.NewSelection("topPt", ana.TreeExpr("t_pt > 100")),
+	}
+
+	// The yields and the cut flow are the same when read
+	// from the cache, fully or with a new variable.
+	var want ana.Yields
+	var wantCF cflow.MultiCutFlow
+	for i, variables := range [][]*ana.Variable{{mtt}, {mtt}, {mtt, pt}} {
+		analyzer, err := ana.New(samples, variables,
+			ana.WithKinemCuts(selections),
+			ana.WithCutFlow(cuts),
+			ana.WithNevtsMax(2000),
+			ana.WithSavePath(path),
+			ana.WithCache(true),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := analyzer.RunEventLoops(); err != nil {
+			t.Fatal(err)
+		}
+		got, err := analyzer.Yields()
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotCF, err := analyzer.CutFlow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			want, wantCF = got, gotCF
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("run %d: got=%+v, want=%+v", i, got, want)
+		}
+		if !reflect.DeepEqual(gotCF, wantCF) {
+			t.Fatalf("run %d: got=%+v, want=%+v", i, gotCF, wantCF)
+		}
+	}
+}
+
+// Cuts made by the same closure, with different captured
+// thresholds, are not read from the cache of the previous one.
+func TestCacheCapturedValues(t *testing.T) {
+	const path = "testdata/Yields_withCapturedValues"
+	os.RemoveAll(path)
+	defer os.RemoveAll(path)
+
+	ptCut := func(thr float32) *ana.Selection {
+		return ana.NewSelection("topPt", ana.TreeFunc{
+			VarsName: []string{"t_pt"},
+			Fct:      func(pt float32) bool { return pt > thr },
+		})
+	}
+	yields := func(thr float32, cache bool) ana.Yields {
+		analyzer, err := ana.New(
+			[]*ana.Sample{ana.CreateSample("bkg", "bkg", `Bkg`, fBkg1, tName)},
+			[]*ana.Variable{ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1000)},
+			ana.WithKinemCuts([]*ana.Selection{ptCut(thr)}),
+			ana.WithSavePath(path),
+			ana.WithCache(cache),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := analyzer.RunEventLoops(); err != nil {
+			t.Fatal(err)
+		}
+		y, err := analyzer.Yields()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return y
+	}
+
+	first := yields(50, true)
+	got, want := yields(200, true), yields(200, false)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid yields from the cache:\ngot= %+v\nwant=%+v", got, want)
+	}
+	if reflect.DeepEqual(got, first) {
+		t.Fatalf("yields of the first threshold reused: %+v", got)
+	}
+}
+
+func ExampleMaker_Yields() {
+	// Samples, with a data sample, two weighted
+	// backgrounds and a signal
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg1", "bkg", `Proc 1`, fBkg1, tName,
+			ana.WithXsec(0.7), ana.WithNgen(1000)),
+		ana.CreateSample("bkg2", "bkg", `Proc 2`, fBkg2, tName,
+			ana.WithWeight(w2), ana.WithXsec(0.6), ana.WithNgen(1000)),
+		ana.CreateSample("ttbar_sig", "sig", `Signal`, fBkg2, tName,
+			ana.WithXsec(0.05), ana.WithNgen(1000)),
+	}
+
+	// Variables
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1000),
+	}
+
+	// Selections
+	selections := []*ana.Selection{
+		ana.EmptySelection(),
+		ana.NewSelection("qq", ana.TreeCut("init_qq")),
+	}
+
+	// Fill the histograms, counting the yields
+	analyzer, err := ana.New(samples, variables,
+		ana.WithKinemCuts(selections),
+		ana.WithLumi(1),
+		ana.WithSavePath("testdata/Yields"),
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err := analyzer.RunEventLoops(); err != nil {
+		panic(err)
+	}
+
+	// Get the yields and write them
+	yields, err := analyzer.Yields()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("bkg1 (all): %.1f ± %.1f (%d events)\n", yields.Values[0][1].Value, yields.Values[0][1].Error, yields.Values[0][1].N)
+	yields.WriteASCII(os.Stdout)
+	yields.WriteMarkdown(os.Stdout)
+
+	// Output:
+	// bkg1 (all): 7000.0 ± 70.0 (10000 events)
+	//
+	// | Sample           |               all |              qq |
+	// |------------------|-------------------|-----------------|
+	// | bkg1             |   7000.00 ± 70.00 |  869.40 ± 24.67 |
+	// | bkg2             |   3000.00 ± 30.00 |  360.00 ± 10.39 |
+	// |------------------|-------------------|-----------------|
+	// | Total background |  10000.00 ± 76.16 | 1229.40 ± 26.77 |
+	// |------------------|-------------------|-----------------|
+	// | ttbar_sig        |     500.00 ± 5.00 |    60.00 ± 1.73 |
+	// |------------------|-------------------|-----------------|
+	// | Total signal     |     500.00 ± 5.00 |    60.00 ± 1.73 |
+	// |------------------|-------------------|-----------------|
+	// | data             | 10000.00 ± 100.00 | 1242.00 ± 35.24 |
+	// |------------------|-------------------|-----------------|
+	// | Data / MC        |     1.000 ± 0.013 |   1.010 ± 0.036 |
+	//
+	// | Sample | all | qq |
+	// |:---|---:|---:|
+	// | bkg1 | 7000.00 ± 70.00 | 869.40 ± 24.67 |
+	// | bkg2 | 3000.00 ± 30.00 | 360.00 ± 10.39 |
+	// | **Total background** | 10000.00 ± 76.16 | 1229.40 ± 26.77 |
+	// | ttbar_sig | 500.00 ± 5.00 | 60.00 ± 1.73 |
+	// | **Total signal** | 500.00 ± 5.00 | 60.00 ± 1.73 |
+	// | data | 10000.00 ± 100.00 | 1242.00 ± 35.24 |
+	// | **Data / MC** | 1.000 ± 0.013 | 1.010 ± 0.036 |
+}
+
+func ExampleWithSaveYields() {
+	// Samples
+	samples := []*ana.Sample{
+		ana.CreateSample("data", "data", `Data`, fBkg1, tName),
+		ana.CreateSample("bkg", "bkg", `Bkg`, fBkg2, tName),
+	}
+
+	// Variables
+	variables := []*ana.Variable{
+		ana.NewVariable("Mttbar", ana.TreeVarF32("ttbar_m"), 25, 350, 1000),
+	}
+
+	// Fill the histograms and save the yields
+	analyzer, err := ana.New(samples, variables,
+		ana.WithNevtsMax(1000),
+		ana.WithSavePath("testdata/Yields_withSaveYields"),
+		ana.WithSaveYields(true),
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err := analyzer.RunEventLoops(); err != nil {
+		panic(err)
+	}
+
+	// Read the LaTeX and CSV tables
+	for _, fname := range []string{"yields.tex", "yields.csv"} {
+		raw, err := ioutil.ReadFile("testdata/Yields_withSaveYields/" + fname)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Print(string(raw))
+	}
+
+	// Output:
+	// \begin{tabular}{l|r}
+	// \hline
+	// Sample & all \\
+	// \hline
+	// bkg & $1000.00 \pm 31.62$ \\
+	// \hline
+	// Total background & $1000.00 \pm 31.62$ \\
+	// \hline
+	// data & $1000.00 \pm 31.62$ \\
+	// \hline
+	// Data / MC & $1.000 \pm 0.045$ \\
+	// \hline
+	// \end{tabular}
+	// selection,sample,yield,error,entries
+	// all,bkg,1000,31.622776601683793,1000
+	// all,Total background,1000,31.622776601683793,1000
+	// all,data,1000,31.622776601683793,1000
+	// all,Data / MC,1,0.044721359549995794,0
+}
@@ -0,0 +1,111 @@
+package ana
+
+import (
+	"image/color"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+
+	"go-hep.org/x/hep/hbook"
+	"go-hep.org/x/hep/hplot"
+)
+
+// FlowMode defines how the underflow and the overflow
+// of the histograms are handled in plots and normalizations.
+type FlowMode int
+
+const (
+	FlowDefault FlowMode = iota // Analysis setting for variables, FlowHide otherwise.
+	FlowHide                    // Under/overflows are not shown.
+	FlowFold                    // Under/overflows are added to the first/last bins.
+	FlowShow                    // Under/overflows are shown as two extra bins.
+)
+
+// Helper function returning the flow mode used
+// for a variable.
+func (ana *Maker) flowMode(v *Variable) FlowMode {
+	if v.FlowMode != FlowDefault {
+		return v.FlowMode
+	}
+	if ana.FlowMode != FlowDefault {
+		return ana.FlowMode
+	}
+	return FlowHide
+}
+
+// Helper function returning the integral of the
+// histogram over the range which is plotted.
+func visibleIntegral(h *hbook.H1D, mode FlowMode) float64 {
+	if mode == FlowHide {
+		return h.Integral(h.XMin(), h.XMax())
+	}
+	return h.Integral()
+}
+
+// Helper function applying the flow mode to the histogram.
+// For FlowShow, the returned histogram has an extra bin on
+// each side, with the width of its neighbour.
+func applyFlowMode(h *hbook.H1D, mode FlowMode) *hbook.H1D {
+	switch mode {
+	case FlowFold:
+		foldFlows(h)
+	case FlowShow:
+		return showFlows(h)
+	}
+	return h
+}
+
+// Helper function adding the underflow and the overflow
+// to the first and the last bins.
+func foldFlows(h *hbook.H1D) {
+	bins := h.Binning.Bins
+	addDist1D(&bins[0].Dist, h.Binning.Outflows[0])
+	addDist1D(&bins[len(bins)-1].Dist, h.Binning.Outflows[1])
+	h.Binning.Outflows = [2]hbook.Dist1D{}
+}
+
+// Helper function returning a copy of the histogram with
+// the underflow and the overflow in two extra bins.
+func showFlows(h *hbook.H1D) *hbook.H1D {
+	bins := h.Binning.Bins
+	n := len(bins)
+	edges := make([]float64, 0, n+3)
+	edges = append(edges, bins[0].XMin()-bins[0].XWidth())
+	for _, b := range bins {
+		edges = append(edges, b.XMin())
+	}
+	edges = append(edges, bins[n-1].XMax(), bins[n-1].XMax()+bins[n-1].XWidth())
+
+	hf := hbook.NewH1DFromEdges(edges)
+	hf.Ann = h.Ann
+	hf.Binning.Bins[0].Dist = h.Binning.Outflows[0]
+	for i, b := range bins {
+		hf.Binning.Bins[i+1].Dist = b.Dist
+	}
+	hf.Binning.Bins[n+1].Dist = h.Binning.Outflows[1]
+	hf.Binning.Dist = h.Binning.Dist
+	return hf
+}
+
+// Helper function adding src to dst.
+func addDist1D(dst *hbook.Dist1D, src hbook.Dist1D) {
+	dst.Dist.N += src.Dist.N
+	dst.Dist.SumW += src.Dist.SumW
+	dst.Dist.SumW2 += src.Dist.SumW2
+	dst.Stats.SumWX += src.Stats.SumWX
+	dst.Stats.SumWX2 += src.Stats.SumWX2
+}
+
+// Helper function returning the plotters shading the
+// underflow and the overflow bins, delimited by dashed
+// lines at the histogram range.
+func flowMarks(v *Variable) []plot.Plotter {
+	shade := color.NRGBA{A: 25}
+	under := hplot.VLine(v.Xmin, shade, nil)
+	over := hplot.VLine(v.Xmax, nil, shade)
+	for _, l := range []*hplot.VertLine{under, over} {
+		l.Line.Color = color.NRGBA{A: 150}
+		l.Line.Dashes = []vg.Length{vg.Points(4), vg.Points(3)}
+	}
+	return []plot.Plotter{under, over}
+}
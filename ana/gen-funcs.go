@@ -0,0 +1,40 @@
+//go:build ignore
+// +build ignore
+
+// Command gen-funcs generates the binding functions of the fast
+// rfunc.Formula of all the functions taking up to four arguments
+// of type bool, int32, int64, float32, float64 or their slices,
+// and returning a bool, a float64 or a []float64.
+//
+// The default signatures are generated in internal/fastfuncs,
+// which is used by package ana. The others are generated in
+// package ana/allfuncs, which is only compiled by the programs
+// importing it, since it is large and takes a while to compile.
+// This file is not committed.
+package main
+
+import (
+	"io/ioutil"
+	"log"
+
+	"github.com/rmadar/tree-gonalyzer/internal/genfuncs"
+)
+
+func main() {
+	for _, out := range []struct {
+		pkg   string
+		fname string
+		sigs  []genfuncs.Signature
+	}{
+		{"fastfuncs", "../internal/fastfuncs/funcs_gen.go", genfuncs.Default()},
+		{"allfuncs", "allfuncs/funcs_gen.go", genfuncs.Others()},
+	} {
+		src, err := genfuncs.Generate(out.pkg, out.sigs)
+		if err != nil {
+			log.Fatalf("could not generate code of %s: %+v", out.pkg, err)
+		}
+		if err := ioutil.WriteFile(out.fname, src, 0644); err != nil {
+			log.Fatalf("could not write %s: %+v", out.fname, err)
+		}
+	}
+}
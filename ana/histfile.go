@@ -0,0 +1,350 @@
+package ana
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"go-hep.org/x/hep/groot"
+	"go-hep.org/x/hep/groot/rhist"
+	"go-hep.org/x/hep/groot/riofs"
+	"go-hep.org/x/hep/groot/root"
+	"go-hep.org/x/hep/hbook"
+	"go-hep.org/x/hep/hbook/rootcnv"
+)
+
+const (
+	histNameSep    = "__"  // Separator of the parts of histogram names.
+	noSelDirName   = "all" // Directory name of the selection without name.
+	systUpSuffix   = "Up"
+	systDownSuffix = "Down"
+)
+
+// Suffixes of the histograms of the sums of a profile.
+var profSuffixes = [3]string{"SumW", "SumWY", "SumWY2"}
+
+// Helper function returning the histograms of the sums of a
+// profile, in the order of profSuffixes.
+func (p *profileHistos) sums() [3]**hbook.H1D {
+	return [3]**hbook.H1D{&p.w, &p.wy, &p.wy2}
+}
+
+// Helper function returning the name of the directory
+// in which the histograms of a selection are saved.
+func selectionDir(sel *Selection) string {
+	if sel.Name == "" {
+		return noSelDirName
+	}
+	return sel.Name
+}
+
+// Helper function returning the name of a saved histogram:
+// <sample>__<variable>, followed by __<syst>Up or __<syst>Down
+// for systematic variations.
+func histName(samp, variable string, syst ...string) string {
+	name := samp + histNameSep + variable
+	for _, s := range syst {
+		name += histNameSep + s
+	}
+	return name
+}
+
+// saveHistos writes all the histograms filled by the event
+// loops in a ROOT file, with one directory per selection:
+//   - <sample>__<variable> for variables (TH1D),
+//   - <sample>__<variable>__<syst>Up/Down for their systematic
+//     variations, when the sample has them (TH1D),
+//   - <sample>__<variable2D> for two-dimensional variables (TH2D),
+//   - <sample>__<profile>__SumW, __SumWY and __SumWY2 for profiles,
+//     with the sums of w, w*y and w*y^2 in each x bin (TH1D).
+//
+// The histograms are not normalized, and include the underflow
+// and the overflow.
+func (ana *Maker) saveHistos(fname string) error {
+
+	saveErr := func(err error) error {
+		return &Error{Op: "save histograms", File: fname, Err: err}
+	}
+
+	// Output directory
+	if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+		return saveErr(err)
+	}
+
+	f, err := groot.Create(fname)
+	if err != nil {
+		return saveErr(err)
+	}
+	defer f.Close()
+
+	for ic, cut := range ana.KinemCuts {
+		dir, err := riofs.Dir(f).Mkdir(selectionDir(cut))
+		if err != nil {
+			return saveErr(err)
+		}
+
+		for is, samp := range ana.Samples {
+			for iv, v := range ana.Variables {
+				name := histName(samp.Name, v.Name)
+				if err := putH1D(dir, name, ana.hbookHistos[is][ic][iv]); err != nil {
+					return saveErr(err)
+				}
+				for k, syst := range ana.hbookSysts[is] {
+					if syst.up == nil {
+						continue
+					}
+					up := histName(samp.Name, v.Name, ana.systNames[k]+systUpSuffix)
+					if err := putH1D(dir, up, syst.up[ic][iv]); err != nil {
+						return saveErr(err)
+					}
+					down := histName(samp.Name, v.Name, ana.systNames[k]+systDownSuffix)
+					if err := putH1D(dir, down, syst.down[ic][iv]); err != nil {
+						return saveErr(err)
+					}
+				}
+			}
+			for iv, v := range ana.Variables2D {
+				name := histName(samp.Name, v.Name)
+				if err := putH2D(dir, name, ana.hbookHistos2D[is][ic][iv]); err != nil {
+					return saveErr(err)
+				}
+			}
+			for ip, p := range ana.Profiles {
+				for k, h := range ana.hbookProfiles[is][ic][ip].sums() {
+					name := histName(samp.Name, p.Name, profSuffixes[k])
+					if err := putH1D(dir, name, *h); err != nil {
+						return saveErr(err)
+					}
+				}
+			}
+		}
+	}
+
+	if err := f.Close(); err != nil {
+		return saveErr(err)
+	}
+	return nil
+}
+
+// Helper function writing a 1D histogram in a directory.
+func putH1D(dir riofs.Directory, name string, h *hbook.H1D) error {
+	hc := *h
+	hc.Ann = hbook.Annotation{"name": name, "title": name}
+	return putObject(dir, name, rhist.NewH1DFrom(&hc))
+}
+
+// Helper function writing a 2D histogram in a directory.
+func putH2D(dir riofs.Directory, name string, h *hbook.H2D) error {
+	hc := *h
+	hc.Ann = hbook.Annotation{"name": name, "title": name}
+	return putObject(dir, name, rhist.NewH2DFrom(&hc))
+}
+
+// Helper function writing an object in a directory.
+func putObject(dir riofs.Directory, name string, obj root.Object) error {
+	if err := dir.Put(name, obj); err != nil {
+		return fmt.Errorf("could not write %q: %w", name, err)
+	}
+	return nil
+}
+
+// NewFromHistos creates an analysis maker as New does, with
+// histograms loaded from the ROOT file fname written by the
+// option WithSaveHistos, instead of being filled by the event
+// loops. The sample files are not read, and PlotVariables can
+// be called right away, e.g. to change the style, the normalization
+// or the ratios of the plots. The binning of a variable can differ
+// from the saved one, as long as each bin edge in the range of the
+// variable is a saved bin edge: the bins are then merged, and the
+// bins outside the range go to the underflow and the overflow,
+// and similarly for the profiles. The yields and the cut flow are
+// not saved: Yields and CutFlow return an error wrapping ErrNoYields.
+func NewFromHistos(s []*Sample, v []*Variable, fname string, opts ...Options) (Maker, error) {
+
+	a, err := newMaker(s, v, opts...)
+	if err != nil {
+		return a, err
+	}
+
+	if err := a.loadHistos(fname); err != nil {
+		return a, err
+	}
+
+	return a, nil
+}
+
+// Helper function loading the histograms from a ROOT
+// file written by saveHistos.
+func (ana *Maker) loadHistos(fname string) error {
+
+	f, err := groot.Open(fname)
+	if err != nil {
+		return &Error{Op: "load histograms", File: fname, Err: err}
+	}
+	defer f.Close()
+
+	ana.systNames = ana.systematicNames()
+	ana.hbookHistos = make([][][]*hbook.H1D, len(ana.Samples))
+	ana.hbookHistos2D = make([][][]*hbook.H2D, len(ana.Samples))
+	ana.hbookProfiles = make([][][]profileHistos, len(ana.Samples))
+	ana.hbookSysts = make([][]systHistos, len(ana.Samples))
+	for is, samp := range ana.Samples {
+		ana.hbookHistos[is] = ana.newHistos()
+		ana.hbookHistos2D[is] = ana.newHistos2D()
+		ana.hbookProfiles[is] = ana.newProfiles()
+		ana.hbookSysts[is] = ana.newSystHistos(samp)
+	}
+
+	for ic, cut := range ana.KinemCuts {
+		for is, samp := range ana.Samples {
+
+			loadErr := func(v string, err error) error {
+				return &Error{
+					Op:        "load histograms",
+					Sample:    samp.Name,
+					File:      fname,
+					Variable:  v,
+					Selection: cut.Name,
+					Err:       err,
+				}
+			}
+
+			for iv, v := range ana.Variables {
+				var err error
+				get := func(name string) *hbook.H1D {
+					if err != nil {
+						return nil
+					}
+					var h *hbook.H1D
+					if h, err = getH1D(f, selectionDir(cut)+"/"+name); err != nil {
+						return nil
+					}
+					if h, err = rebinH1D(h, v); err != nil {
+						return nil
+					}
+					return h
+				}
+				ana.hbookHistos[is][ic][iv] = get(histName(samp.Name, v.Name))
+				for k, syst := range ana.hbookSysts[is] {
+					if syst.up == nil {
+						continue
+					}
+					syst.up[ic][iv] = get(histName(samp.Name, v.Name, ana.systNames[k]+systUpSuffix))
+					syst.down[ic][iv] = get(histName(samp.Name, v.Name, ana.systNames[k]+systDownSuffix))
+				}
+				if err != nil {
+					return loadErr(v.Name, err)
+				}
+			}
+
+			for iv, v := range ana.Variables2D {
+				h, err := getH2D(f, selectionDir(cut)+"/"+histName(samp.Name, v.Name))
+				if err != nil {
+					return loadErr(v.Name, err)
+				}
+				ana.hbookHistos2D[is][ic][iv] = h
+			}
+
+			for ip, p := range ana.Profiles {
+				binning := &Variable{Nbins: p.Nbins, Xmin: p.Xmin, Xmax: p.Xmax}
+				prof := &ana.hbookProfiles[is][ic][ip]
+				for k, h := range prof.sums() {
+					name := histName(samp.Name, p.Name, profSuffixes[k])
+					hs, err := getH1D(f, selectionDir(cut)+"/"+name)
+					if err == nil {
+						hs, err = rebinH1D(hs, binning)
+					}
+					if err != nil {
+						return loadErr(p.Name, err)
+					}
+					*h = hs
+				}
+			}
+		}
+	}
+
+	ana.histoFilled = true
+	ana.histoLoaded = true
+
+	return nil
+}
+
+// Helper function reading a 1D histogram from a file.
+func getH1D(f *riofs.File, path string) (*hbook.H1D, error) {
+	obj, err := riofs.Dir(f).Get(path)
+	if err != nil {
+		return nil, err
+	}
+	h, ok := obj.(rhist.H1)
+	if !ok {
+		return nil, fmt.Errorf("%q is a %s, not a 1D histogram", path, obj.Class())
+	}
+	return rootcnv.H1D(h), nil
+}
+
+// Helper function reading a 2D histogram from a file.
+func getH2D(f *riofs.File, path string) (*hbook.H2D, error) {
+	obj, err := riofs.Dir(f).Get(path)
+	if err != nil {
+		return nil, err
+	}
+	h, ok := obj.(rhist.H2)
+	if !ok {
+		return nil, fmt.Errorf("%q is a %s, not a 2D histogram", path, obj.Class())
+	}
+	return rootcnv.H2D(h), nil
+}
+
+// Helper function returning the histogram with the binning
+// of the variable, merging its bins. The bins outside the
+// range of the variable are added to the underflow and the
+// overflow.
+func rebinH1D(h *hbook.H1D, v *Variable) (*hbook.H1D, error) {
+
+	edges := v.BinEdges
+	if len(edges) == 0 {
+		edges = make([]float64, v.Nbins+1)
+		for i := range edges {
+			edges[i] = v.Xmin + float64(i)*(v.Xmax-v.Xmin)/float64(v.Nbins)
+		}
+	}
+
+	// Index of the new bin of each edge of the histogram,
+	// checking that all new edges are histogram edges.
+	bins := h.Binning.Bins
+	hEdges := make([]float64, 0, len(bins)+1)
+	hEdges = append(hEdges, bins[0].XMin())
+	for _, b := range bins {
+		hEdges = append(hEdges, b.XMax())
+	}
+	eps := 1e-9 * (hEdges[len(hEdges)-1] - hEdges[0])
+	j := 0
+	for _, e := range hEdges {
+		if j < len(edges) && math.Abs(e-edges[j]) <= eps {
+			j++
+		}
+	}
+	if j < len(edges) {
+		return nil, fmt.Errorf("%w: edge %v is not a saved bin edge", ErrRebin, edges[j])
+	}
+
+	hr := hbook.NewH1DFromEdges(edges)
+	hr.Ann = h.Ann
+	hr.Binning.Dist = h.Binning.Dist
+	hr.Binning.Outflows = h.Binning.Outflows
+	xmin, xmax := edges[0]+eps, edges[len(edges)-1]-eps
+	for _, b := range bins {
+		switch {
+		case b.XMax() <= xmin:
+			addDist1D(&hr.Binning.Outflows[0], b.Dist)
+		case b.XMin() >= xmax:
+			addDist1D(&hr.Binning.Outflows[1], b.Dist)
+		default:
+			i := sort.SearchFloat64s(edges, b.XMid()) - 1
+			addDist1D(&hr.Binning.Bins[i].Dist, b.Dist)
+		}
+	}
+	return hr, nil
+}
@@ -0,0 +1,96 @@
+package ana
+
+import (
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"go-hep.org/x/hep/hbook"
+)
+
+// Profiles loaded from the saved histograms are the same as
+// the ones filled by the event loops, with the saved binning
+// or with merged bins.
+func TestSaveProfiles(t *testing.T) {
+	const path = "testdata/Histos_withProfiles"
+	os.RemoveAll(path)
+	defer os.RemoveAll(path)
+
+	samples := func() []*Sample {
+		return []*Sample{
+			CreateSample("data", "data", `Data`, "../testdata/file2.root", "truth"),
+			CreateSample("bkg", "bkg", `Bkg`, "../testdata/file3.root", "truth",
+				WithWeight(TreeValF64(0.5))),
+		}
+	}
+	profiles := func(nbins int) []*Profile {
+		return []*Profile{
+			NewProfile("TopPtVsMtt", TreeVarF32("ttbar_m"), TreeVarF32("t_pt"), nbins, 350, 1000),
+		}
+	}
+	selections := []*Selection{
+		EmptySelection(),
+		NewSelection("qq", TreeCut("init_qq")),
+	}
+
+	run := func(nbins int, opts ...Options) Maker {
+		opts = append(opts,
+			WithKinemCuts(selections),
+			WithProfiles(profiles(nbins)),
+			WithSavePath(path),
+		)
+		a, err := New(samples(), []*Variable{}, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := a.RunEventLoops(); err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+
+	run(26, WithSaveHistos("histos.root"))
+	for _, nbins := range []int{26, 13} {
+		got, err := NewFromHistos(samples(), []*Variable{}, path+"/histos.root",
+			WithKinemCuts(selections),
+			WithProfiles(profiles(nbins)),
+		)
+		if err != nil {
+			t.Fatalf("could not load profiles: %+v", err)
+		}
+		if _, err := got.Yields(); !errors.Is(err, ErrNoYields) {
+			t.Fatalf("expected ErrNoYields from Yields, got %v", err)
+		}
+		if _, err := got.CutFlow(); !errors.Is(err, ErrNoYields) {
+			t.Fatalf("expected ErrNoYields from CutFlow, got %v", err)
+		}
+		want := run(nbins)
+		for is := range want.hbookProfiles {
+			for ic := range want.hbookProfiles[is] {
+				g, w := got.hbookProfiles[is][ic][0].points(), want.hbookProfiles[is][ic][0].points()
+				if len(w) == 0 || !equalPoints(g, w) {
+					t.Fatalf("nbins=%d, sample=%d, selection=%d: invalid profile:\ngot= %v\nwant=%v", nbins, is, ic, g, w)
+				}
+			}
+		}
+	}
+}
+
+// Helper function comparing points, up to rounding errors
+// of the sums of merged bins.
+func equalPoints(a, b []hbook.Point2D) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	eq := func(x, y float64) bool {
+		return math.Abs(x-y) <= 1e-9*math.Max(math.Abs(x), math.Abs(y))
+	}
+	for i := range a {
+		p, q := a[i], b[i]
+		if !eq(p.X, q.X) || !eq(p.Y, q.Y) || !eq(p.ErrX.Min, q.ErrX.Min) || !eq(p.ErrY.Min, q.ErrY.Min) {
+			return false
+		}
+	}
+	return true
+}
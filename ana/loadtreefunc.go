@@ -1,665 +1,119 @@
 package ana
 
+//go:generate go run ./gen-funcs.go
+
 import (
 	"fmt"
 	"reflect"
+	"sync"
 
 	"go-hep.org/x/hep/groot/rtree/rfunc"
-)
-
-// (bool) -> float64
-func newFuncBoolToF64(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncBoolToF64{
-		rvars: varsName,
-		fct:   fct.(func(bool) float64),
-	}, nil
-}
-
-type userFuncBoolToF64 struct {
-	rvars []string
-	v1    *bool
-	fct   func(bool) float64
-}
-
-func (usr *userFuncBoolToF64) RVars() []string { return usr.rvars }
-
-func (usr *userFuncBoolToF64) Bind(args []interface{}) error {
-	if got, want := len(args), 1; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*bool)
-	return nil
-}
-
-func (usr *userFuncBoolToF64) Func() interface{} {
-	return func() float64 {
-		return usr.fct(*usr.v1)
-	}
-}
-
-// ([]float32, float64) -> []float64
-func newFuncF32sF64ToF64s(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncF32sF64ToF64s{
-		rvars: varsName,
-		fct:   fct.(func([]float32, float64) []float64),
-	}, nil
-}
-
-type userFuncF32sF64ToF64s struct {
-	rvars []string
-	v1    *[]float32
-	v2    *float64
-	fct   func([]float32, float64) []float64
-}
-
-func (usr *userFuncF32sF64ToF64s) RVars() []string { return usr.rvars }
-
-func (usr *userFuncF32sF64ToF64s) Bind(args []interface{}) error {
-	if got, want := len(args), 2; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*[]float32)
-	usr.v2 = args[1].(*float64)
-	return nil
-}
-
-func (usr *userFuncF32sF64ToF64s) Func() interface{} {
-	return func() []float64 {
-		return usr.fct(*usr.v1, *usr.v2)
-	}
-}
-
-// ([]float32, float32) -> []float64
-func newFuncF32sF32ToF64s(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncF32sF32ToF64s{
-		rvars: varsName,
-		fct:   fct.(func([]float32, float32) []float64),
-	}, nil
-}
-
-type userFuncF32sF32ToF64s struct {
-	rvars []string
-	v1    *[]float32
-	v2    *float32
-	fct   func([]float32, float32) []float64
-}
-
-func (usr *userFuncF32sF32ToF64s) RVars() []string { return usr.rvars }
-
-func (usr *userFuncF32sF32ToF64s) Bind(args []interface{}) error {
-	if got, want := len(args), 2; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*[]float32)
-	usr.v2 = args[1].(*float32)
-	return nil
-}
-
-func (usr *userFuncF32sF32ToF64s) Func() interface{} {
-	return func() []float64 {
-		return usr.fct(*usr.v1, *usr.v2)
-	}
-}
-
-// (float32, float32) -> float64
-func newFuncF32F32ToF64(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncF32F32ToF64{
-		rvars: varsName,
-		fct:   fct.(func(float32, float32) float64),
-	}, nil
-}
-
-type userFuncF32F32ToF64 struct {
-	rvars []string
-	v1    *float32
-	v2    *float32
-	fct   func(float32, float32) float64
-}
-
-func (usr *userFuncF32F32ToF64) RVars() []string { return usr.rvars }
-
-func (usr *userFuncF32F32ToF64) Bind(args []interface{}) error {
-	if got, want := len(args), 2; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*float32)
-	usr.v2 = args[1].(*float32)
-	return nil
-}
-
-func (usr *userFuncF32F32ToF64) Func() interface{} {
-	return func() float64 {
-		return usr.fct(*usr.v1, *usr.v2)
-	}
-}
-
-// (bool) -> bool
-func newFuncBoolToBool(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncBoolToBool{
-		rvars: varsName,
-		fct:   fct.(func(bool) bool),
-	}, nil
-}
-
-type userFuncBoolToBool struct {
-	rvars []string
-	v1    *bool
-	fct   func(bool) bool
-}
-
-func (usr *userFuncBoolToBool) RVars() []string { return usr.rvars }
-
-func (usr *userFuncBoolToBool) Bind(args []interface{}) error {
-	if got, want := len(args), 1; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*bool)
-	return nil
-}
-
-func (usr *userFuncBoolToBool) Func() interface{} {
-	return func() bool {
-		return usr.fct(*usr.v1)
-	}
-}
-
-// (I32) -> bool
-func newFuncI32ToBool(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncI32ToBool{
-		rvars: varsName,
-		fct:   fct.(func(int32) bool),
-	}, nil
-}
-
-type userFuncI32ToBool struct {
-	rvars []string
-	v1    *int32
-	fct   func(int32) bool
-}
-
-func (usr *userFuncI32ToBool) RVars() []string { return usr.rvars }
-
-func (usr *userFuncI32ToBool) Bind(args []interface{}) error {
-	if got, want := len(args), 1; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*int32)
-	return nil
-}
-
-func (usr *userFuncI32ToBool) Func() interface{} {
-	return func() bool {
-		return usr.fct(*usr.v1)
-	}
-}
-
-// (bool, float32) -> bool
-func newFuncBoolF32ToBool(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncBoolF32ToBool{
-		rvars: varsName,
-		fct:   fct.(func(bool, float32) bool),
-	}, nil
-}
-
-type userFuncBoolF32ToBool struct {
-	rvars []string
-	v1    *bool
-	v2    *float32
-	fct   func(bool, float32) bool
-}
-
-func (usr *userFuncBoolF32ToBool) RVars() []string { return usr.rvars }
-
-func (usr *userFuncBoolF32ToBool) Bind(args []interface{}) error {
-	if got, want := len(args), 2; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*bool)
-	usr.v2 = args[1].(*float32)
-	return nil
-}
-
-func (usr *userFuncBoolF32ToBool) Func() interface{} {
-	return func() bool {
-		return usr.fct(*usr.v1, *usr.v2)
-	}
-}
-
-// (bool, int32) -> bool
-func newFuncBoolI32ToBool(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncBoolI32ToBool{
-		rvars: varsName,
-		fct:   fct.(func(bool, int32) bool),
-	}, nil
-}
-
-type userFuncBoolI32ToBool struct {
-	rvars []string
-	v1    *bool
-	v2    *int32
-	fct   func(bool, int32) bool
-}
-
-func (usr *userFuncBoolI32ToBool) RVars() []string { return usr.rvars }
-
-func (usr *userFuncBoolI32ToBool) Bind(args []interface{}) error {
-	if got, want := len(args), 2; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*bool)
-	usr.v2 = args[1].(*int32)
-	return nil
-}
-
-func (usr *userFuncBoolI32ToBool) Func() interface{} {
-	return func() bool {
-		return usr.fct(*usr.v1, *usr.v2)
-	}
-}
-
-// ([]float32) -> []float64
-func newFuncF32sToF64s(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncF32sToF64s{
-		rvars: varsName,
-		fct:   fct.(func([]float32) []float64),
-	}, nil
-}
-
-type userFuncF32sToF64s struct {
-	rvars []string
-	v1    *[]float32
-	fct   func([]float32) []float64
-}
 
-func (usr *userFuncF32sToF64s) RVars() []string { return usr.rvars }
-
-func (usr *userFuncF32sToF64s) Bind(args []interface{}) error {
-	if got, want := len(args), 1; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*[]float32)
-	return nil
-}
-
-func (usr *userFuncF32sToF64s) Func() interface{} {
-	return func() []float64 {
-		return usr.fct(*usr.v1)
-	}
-}
-
-// ([]float32, []float32, []float32) -> float64
-func newFuncF32sF32sF32sToF64(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncF32sF32sF32sToF64{
-		rvars: varsName,
-		fct:   fct.(func([]float32, []float32, []float32) float64),
-	}, nil
-}
-
-type userFuncF32sF32sF32sToF64 struct {
-	rvars []string
-	v1    *[]float32
-	v2    *[]float32
-	v3    *[]float32
-	fct   func([]float32, []float32, []float32) float64
-}
+	"github.com/rmadar/tree-gonalyzer/internal/fastfuncs"
+)
 
-func (usr *userFuncF32sF32sF32sToF64) RVars() []string { return usr.rvars }
+// FormulaMaker creates the rfunc.Formula of the function fct,
+// reading the branches rvars.
+type FormulaMaker func(rvars []string, fct interface{}) (rfunc.Formula, error)
 
-func (usr *userFuncF32sF32sF32sToF64) Bind(args []interface{}) error {
-	if got, want := len(args), 3; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*[]float32)
-	usr.v2 = args[1].(*[]float32)
-	usr.v3 = args[2].(*[]float32)
-	return nil
-}
+// Maps of the function types registered by users with
+// RegisterFormula. The generated ones are looked up in
+// internal/fastfuncs, filled by gen-funcs.go's code.
+var funcs = struct {
+	sync.RWMutex
+	m map[reflect.Type]FormulaMaker
+}{m: make(map[reflect.Type]FormulaMaker)}
 
-func (usr *userFuncF32sF32sF32sToF64) Func() interface{} {
-	return func() float64 {
-		return usr.fct(*usr.v1, *usr.v2, *usr.v3)
+// RegisterFormula registers the maker of a fast rfunc.Formula
+// for the functions having the type of the prototype fct, eg
+// (func(float32, []float64) float32)(nil). The maker is then used
+// for all TreeFunc's with such a function, instead of the generic
+// rfunc.Formula based on reflect. It can be called from init(),
+// and returns an error if the prototype is not a function, or if
+// its type has already a fast formula.
+func RegisterFormula(fct interface{}, maker FormulaMaker) error {
+	typ := reflect.TypeOf(fct)
+	if typ == nil || typ.Kind() != reflect.Func {
+		return fmt.Errorf("%w: prototype %T is not a function", ErrRegistry, fct)
 	}
-}
-
-// ([]int32, []float32) -> []float64
-func newFuncI32sF32sToF64s(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncI32sF32sToF64s{
-		rvars: varsName,
-		fct:   fct.(func([]int32, []float32) []float64),
-	}, nil
-}
-
-type userFuncI32sF32sToF64s struct {
-	rvars []string
-	v1    *[]int32
-	v2    *[]float32
-	fct   func([]int32, []float32) []float64
-}
-
-func (usr *userFuncI32sF32sToF64s) RVars() []string { return usr.rvars }
-
-func (usr *userFuncI32sF32sToF64s) Bind(args []interface{}) error {
-	if got, want := len(args), 2; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
+	if maker == nil {
+		return fmt.Errorf("%w: nil maker for %v", ErrRegistry, typ)
 	}
-	usr.v1 = args[0].(*[]int32)
-	usr.v2 = args[1].(*[]float32)
-	return nil
-}
-
-func (usr *userFuncI32sF32sToF64s) Func() interface{} {
-	return func() []float64 {
-		return usr.fct(*usr.v1, *usr.v2)
+	funcs.Lock()
+	defer funcs.Unlock()
+	if _, dup := funcs.m[typ]; dup {
+		return fmt.Errorf("%w: %v has already a formula", ErrRegistry, typ)
 	}
-}
-
-// (int32, float64) -> float64
-func newFuncI32F64ToF64(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncI32F64ToF64{
-		rvars: varsName,
-		fct:   fct.(func(int32, float64) float64),
-	}, nil
-}
-
-type userFuncI32F64ToF64 struct {
-	rvars []string
-	v1    *int32
-	v2    *float64
-	fct   func(int32, float64) float64
-}
-
-func (usr *userFuncI32F64ToF64) RVars() []string { return usr.rvars }
-
-func (usr *userFuncI32F64ToF64) Bind(args []interface{}) error {
-	if got, want := len(args), 2; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
+	if _, dup := fastfuncs.Funcs[typ]; dup {
+		return fmt.Errorf("%w: %v has already a formula", ErrRegistry, typ)
 	}
-	usr.v1 = args[0].(*int32)
-	usr.v2 = args[1].(*float64)
+	funcs.m[typ] = maker
 	return nil
 }
 
-func (usr *userFuncI32F64ToF64) Func() interface{} {
-	return func() float64 {
-		return usr.fct(*usr.v1, *usr.v2)
+// Helper function returning the maker of the fast
+// rfunc.Formula of fct, if any.
+func formulaMaker(fct interface{}) (FormulaMaker, bool) {
+	typ := reflect.TypeOf(fct)
+	funcs.RLock()
+	mk, ok := funcs.m[typ]
+	funcs.RUnlock()
+	if ok {
+		return mk, true
 	}
-}
-
-// (int32, float32) -> float64
-func newFuncI32F32ToF64(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncI32F32ToF64{
-		rvars: varsName,
-		fct:   fct.(func(int32, float32) float64),
-	}, nil
-}
-
-type userFuncI32F32ToF64 struct {
-	rvars []string
-	v1    *int32
-	v2    *float32
-	fct   func(int32, float32) float64
-}
-
-func (usr *userFuncI32F32ToF64) RVars() []string { return usr.rvars }
-
-func (usr *userFuncI32F32ToF64) Bind(args []interface{}) error {
-	if got, want := len(args), 2; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
+	f, ok := fastfuncs.Funcs[typ]
+	if !ok {
+		return nil, false
 	}
-	usr.v1 = args[0].(*int32)
-	usr.v2 = args[1].(*float32)
-	return nil
+	return newFastFormulaMaker(f.Bind), true
 }
 
-func (usr *userFuncI32F32ToF64) Func() interface{} {
-	return func() float64 {
-		return usr.fct(*usr.v1, *usr.v2)
+// Helper function returning the maker of a fast rfunc.Formula,
+// bind receiving the user function and the pointers to the branch
+// values, of the types of its arguments, and returning the function
+// closing on them.
+func newFastFormulaMaker(bind func(fct interface{}, ptrs []interface{}) interface{}) FormulaMaker {
+	return func(rvars []string, fct interface{}) (rfunc.Formula, error) {
+		typ := reflect.TypeOf(fct)
+		if typ == nil || typ.Kind() != reflect.Func {
+			return nil, fmt.Errorf("rfunc: %T is not a function", fct)
+		}
+		if len(rvars) != typ.NumIn() {
+			return nil, fmt.Errorf("rfunc: num-branches/func-arity mismatch")
+		}
+		return &fastFormula{rvars: rvars, typ: typ, fct: fct, bind: bind}, nil
 	}
 }
 
-// (int32, int32) -> float64
-func newFuncI32I32ToF64(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncI32I32ToF64{
-		rvars: varsName,
-		fct:   fct.(func(int32, int32) float64),
-	}, nil
-}
-
-type userFuncI32I32ToF64 struct {
+// fastFormula is a rfunc.Formula calling the user
+// function on the bound arguments, without reflection.
+type fastFormula struct {
 	rvars []string
-	v1    *int32
-	v2    *int32
-	fct   func(int32, int32) float64
+	typ   reflect.Type
+	fct   interface{}
+	bind  func(fct interface{}, ptrs []interface{}) interface{}
+	f     interface{}
 }
 
-func (usr *userFuncI32I32ToF64) RVars() []string { return usr.rvars }
+func (ff *fastFormula) RVars() []string { return ff.rvars }
 
-func (usr *userFuncI32I32ToF64) Bind(args []interface{}) error {
-	if got, want := len(args), 2; got != want {
+func (ff *fastFormula) Bind(args []interface{}) error {
+	if got, want := len(args), len(ff.rvars); got != want {
 		return fmt.Errorf(
 			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
 			got, want,
 		)
 	}
-	usr.v1 = args[0].(*int32)
-	usr.v2 = args[1].(*int32)
-	return nil
-}
-
-func (usr *userFuncI32I32ToF64) Func() interface{} {
-	return func() float64 {
-		return usr.fct(*usr.v1, *usr.v2)
+	for i, arg := range args {
+		if got, want := reflect.TypeOf(arg), reflect.PtrTo(ff.typ.In(i)); got != want {
+			return fmt.Errorf(
+				"rfunc: argument type %d (name=%s) mismatch: got=%v, want=%v",
+				i, ff.rvars[i], got, want,
+			)
+		}
 	}
-}
-
-// (float32, float32, float32, float32) -> float64
-func newFuncF32F32F32F32ToF64(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncF32F32F32F32ToF64{
-		rvars: varsName,
-		fct:   fct.(func(float32, float32, float32, float32) float64),
-	}, nil
-}
-
-type userFuncF32F32F32F32ToF64 struct {
-	rvars []string
-	v1    *float32
-	v2    *float32
-	v3    *float32
-	v4    *float32
-	fct   func(float32, float32, float32, float32) float64
-}
-
-func (usr *userFuncF32F32F32F32ToF64) RVars() []string { return usr.rvars }
-
-func (usr *userFuncF32F32F32F32ToF64) Bind(args []interface{}) error {
-	if got, want := len(args), 4; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*float32)
-	usr.v2 = args[1].(*float32)
-	usr.v3 = args[1].(*float32)
-	usr.v4 = args[1].(*float32)
-	return nil
-}
-
-func (usr *userFuncF32F32F32F32ToF64) Func() interface{} {
-	return func() float64 {
-		return usr.fct(*usr.v1, *usr.v2, *usr.v3, *usr.v4)
-	}
-}
-
-
-
-
-
-
-// ([]float32) -> float64
-func newFuncF32sToF64(varsName []string, fct interface{}) (rfunc.Formula, error) {
-	return &userFuncF32sToF64{
-		rvars: varsName,
-		fct:   fct.(func([]float32) float64),
-	}, nil
-}
-
-type userFuncF32sToF64 struct {
-	rvars []string
-	v1    *[]float32
-	fct   func([]float32) float64
-}
-
-func (usr *userFuncF32sToF64) RVars() []string { return usr.rvars }
-
-func (usr *userFuncF32sToF64) Bind(args []interface{}) error {
-	if got, want := len(args), 1; got != want {
-		return fmt.Errorf(
-			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
-			got, want,
-		)
-	}
-	usr.v1 = args[0].(*[]float32)
+	ff.f = ff.bind(ff.fct, args)
 	return nil
 }
 
-func (usr *userFuncF32sToF64) Func() interface{} {
-	return func() float64 {
-		return usr.fct(*usr.v1)
-	}
-}
-
-
-
-
-
-// Maps of all pre-defined function types.
-var funcs = make(map[reflect.Type]func(rvars []string, fct interface{}) (rfunc.Formula, error))
-
-// Load rfunc.Formula functions which are pre-defined in rtree/rfunc
-// or user-defined rfunc.
-func init() {
-
-	// () -> bool
-	funcs[reflect.TypeOf((func() bool)(nil))] = func(rvars []string, fct interface{}) (rfunc.Formula, error) {
-		return rfunc.NewFuncToBool(rvars, fct.(func() bool)), nil
-	}
-
-	// () -> float64
-	funcs[reflect.TypeOf((func() float64)(nil))] = func(rvars []string, fct interface{}) (rfunc.Formula, error) {
-		return rfunc.NewFuncToF64(rvars, fct.(func() float64)), nil
-	}
-
-	// (float64) -> float64
-	funcs[reflect.TypeOf((func(float64) float64)(nil))] = func(rvars []string, fct interface{}) (rfunc.Formula, error) {
-		return rfunc.NewFuncF64ToF64(rvars, fct.(func(float64) float64)), nil
-	}
-
-	// (float32) -> float64
-	funcs[reflect.TypeOf((func(float32) float64)(nil))] = func(rvars []string, fct interface{}) (rfunc.Formula, error) {
-		return rfunc.NewFuncF32ToF64(rvars, fct.(func(float32) float64)), nil
-	}
-
-	// (float32) -> bool
-	funcs[reflect.TypeOf((func(float32) bool)(nil))] = func(rvars []string, fct interface{}) (rfunc.Formula, error) {
-		return rfunc.NewFuncF32ToBool(rvars, fct.(func(float32) bool)), nil
-	}
-
-	// (float64) -> bool
-	funcs[reflect.TypeOf((func(float64) bool)(nil))] = func(rvars []string, fct interface{}) (rfunc.Formula, error) {
-		return rfunc.NewFuncF64ToBool(rvars, fct.(func(float64) bool)), nil
-	}
-
-	// (int32) -> float64
-	funcs[reflect.TypeOf((func(int32) float64)(nil))] = func(rvars []string, fct interface{}) (rfunc.Formula, error) {
-		return rfunc.NewFuncI32ToF64(rvars, fct.(func(int32) float64)), nil
-	}
-
-	// (bool) -> bool
-	funcs[reflect.TypeOf((func(bool) bool)(nil))] = newFuncBoolToBool
-
-	// (int32) -> bool
-	funcs[reflect.TypeOf((func(int32) bool)(nil))] = newFuncI32ToBool
-
-	// (bool) -> float64
-	funcs[reflect.TypeOf((func(bool) float64)(nil))] = newFuncBoolToF64
-
-	// ([]float32, float64) -> float64[]
-	funcs[reflect.TypeOf((func([]float32, float64) []float64)(nil))] = newFuncF32sF64ToF64s
-	
-	// ([]float32, float32) -> float64[]
-	funcs[reflect.TypeOf((func([]float32, float32) []float64)(nil))] = newFuncF32sF32ToF64s
-
-	// (float32, float32) -> float64
-	funcs[reflect.TypeOf((func(float32, float32) float64)(nil))] = newFuncF32F32ToF64
-
-	// ([]float32) -> []float64
-	funcs[reflect.TypeOf((func([]float32) []float64)(nil))] = newFuncF32sToF64s
-
-	// ([]float32, []float32, []float32) -> float64
-	funcs[reflect.TypeOf((func([]float32, []float32, []float32) float64)(nil))] = newFuncF32sF32sF32sToF64
-
-	// ([]int32, []float32) -> []float64
-	funcs[reflect.TypeOf((func([]int32, []float32) []float64)(nil))] = newFuncI32sF32sToF64s
-
-	// (bool, float32) -> bool
-	funcs[reflect.TypeOf((func(bool, float32) bool)(nil))] = newFuncBoolF32ToBool
-
-	// (bool, int32) -> bool
-	funcs[reflect.TypeOf((func(bool, int32) bool)(nil))] = newFuncBoolI32ToBool
-
-	// (int32, int32) -> float64
-	funcs[reflect.TypeOf((func(int32, int32) float64)(nil))] = newFuncI32I32ToF64
-
-	// (int32, float32) -> float64
-	funcs[reflect.TypeOf((func(int32, float32) float64)(nil))] = newFuncI32F32ToF64
-
-	// (int32, float64) -> float64
-	funcs[reflect.TypeOf((func(int32, float64) float64)(nil))] = newFuncI32F64ToF64
-
-	// (float32, float32, float32, float32) -> float64
-	funcs[reflect.TypeOf((func(float32, float32, float32, float32) float64)(nil))] = newFuncF32F32F32F32ToF64
-
-	// ([]float32) -> float64
-	funcs[reflect.TypeOf((func([]float32) float64)(nil))] = newFuncF32sToF64
-
-}
+func (ff *fastFormula) Func() interface{} { return ff.f }
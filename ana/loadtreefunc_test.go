@@ -0,0 +1,55 @@
+package ana
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rmadar/tree-gonalyzer/internal/fastfuncs"
+	"github.com/rmadar/tree-gonalyzer/internal/fastfuncs/fastfuncstest"
+)
+
+// Test that the fast formulas of the default function types give
+// the same results as the generic formula. Those of ana/allfuncs
+// are tested in their package.
+func TestFastFormulas(t *testing.T) {
+	for typ := range fastfuncs.Funcs {
+		mk, _ := formulaMaker(reflect.Zero(typ).Interface())
+		bind := func(rvars []string, fct interface{}, ptrs []interface{}) (interface{}, error) {
+			f, err := mk(rvars, fct)
+			if err != nil {
+				return nil, err
+			}
+			if err := f.Bind(ptrs); err != nil {
+				return nil, err
+			}
+			return f.Func(), nil
+		}
+		if err := fastfuncstest.Check(typ, bind); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFastFormulaBindErrors(t *testing.T) {
+	mk, _ := formulaMaker((func(float32, int32) float64)(nil))
+
+	if _, err := mk([]string{"x"}, func(float32, int32) float64 { return 0 }); err == nil {
+		t.Fatalf("expected an arity error")
+	}
+
+	f, err := mk([]string{"x", "y"}, func(float32, int32) float64 { return 0 })
+	if err != nil {
+		t.Fatalf("could not create formula: %+v", err)
+	}
+	if err := f.Bind([]interface{}{new(float32), new(int64)}); err == nil {
+		t.Fatalf("expected a type mismatch error")
+	}
+	for _, arg := range []interface{}{nil, int32(0)} {
+		if err := f.Bind([]interface{}{new(float32), arg}); err == nil {
+			t.Fatalf("expected a type mismatch error for %T", arg)
+		}
+	}
+	if err := f.Bind([]interface{}{new(float32)}); err == nil {
+		t.Fatalf("expected a number of arguments error")
+	}
+}
@@ -2,9 +2,9 @@
 package ana
 
 import (
+	"context"
 	"fmt"
 	"image/color"
-	"log"
 	"time"
 
 	"go-hep.org/x/hep/hbook"
@@ -18,18 +18,25 @@ import (
 type Maker struct {
 
 	// Inputs
-	Samples   []*Sample    // List of samples on which to run.
-	Variables []*Variable  // List of variables to plot.
-	KinemCuts []*Selection // List of cuts to apply (default: no cut).
-	NevtsMax  int64        // Maximum event number per components (default: -1),
-	Lumi      float64      // Integrated luminosity en 1/fb (default: 1/pb).
-	SampleMT  bool         // Enable concurency accross samples (default: true).
+	Samples     []*Sample     // List of samples on which to run.
+	Variables   []*Variable   // List of variables to plot.
+	Variables2D []*Variable2D // List of two-dimensional variables to plot (default: none).
+	Profiles    []*Profile    // List of profiles to plot (default: none).
+	KinemCuts   []*Selection  // List of cuts to apply (default: no cut).
+	CutFlowCuts []*Selection  // List of cuts applied cumulatively for the cut flow (default: none).
+	NevtsMax    int64         // Maximum event number per components (default: -1),
+	Lumi        float64       // Integrated luminosity en 1/fb (default: 1/pb).
+	SampleMT    bool          // Enable concurency accross samples (default: true).
+	NWorkers    int           // Number of workers over entry ranges, if >0 (default: 0).
 
 	// Ouputs
 	SavePath     string // Path to which plot will be saved (default: 'outputs').
 	SaveFormat   string // Plot file extension: 'png' (default), 'pdf' or 'png'.
 	CompileLatex bool   // On-the-fly latex compilation (default: true).
 	DumpTree     bool   // Dump a TTree in a file for each sample (default: false).
+	SaveHistos   string // ROOT file of histograms in SavePath, if not empty (default: '').
+	SaveYields   bool   // Save the yield tables in SavePath (default: false).
+	Cache        bool   // Cache the histograms of each component in SavePath/.cache (default: false).
 	PlotHisto    bool   // Enable histogram plotting (default: true).
 
 	// Plots
@@ -40,6 +47,7 @@ type Maker struct {
 	HistoNorm      bool        // Normalize distributions to unit area (default: false).
 	TotalBand      bool        // Enable total error band in stack mode (default: true).
 	TotalBandColor color.NRGBA // Color for the uncertainty band (default: gray).
+	FlowMode       FlowMode    // Under/overflow handling of variables (default: FlowHide).
 
 	// Enable ratio plot (default: true).
 	// If stack is on, the ratio is defined as data over total bkg.
@@ -50,30 +58,78 @@ type Maker struct {
 	// Histograms for {samples x selections x variables}
 	hbookHistos [][][]*hbook.H1D
 
+	// Systematic variations: names, and varied histograms
+	// for {samples x systematics} x {selections x variables}
+	systNames  []string
+	hbookSysts [][]systHistos
+
+	// 2D histograms for {samples x selections x 2D variables}
+	hbookHistos2D [][][]*hbook.H2D
+
+	// Profiles for {samples x selections x profiles}
+	hbookProfiles [][][]profileHistos
+
+	// Yields for {samples x selections}
+	yields [][]yieldCount
+
+	// Cut-flow counts for {samples x steps}, the first
+	// step being before the cut-flow cuts, and whether
+	// their tables are not saved (RunCutFlows)
+	cutFlow       [][]yieldCount
+	noCutFlowSave bool
+
+	// Cache of histograms for {samples x components},
+	// nil if the cache is not used
+	cache [][]*compCache
+
 	// tree dumping
 	nVars       int     // number of variables
 	nEvtsSample []int64 // number of events per sample
 
-	// Normalisation of each sample for each cut:
-	// {selections x samples}
-	normHists [][]float64
+	// Normalisation of each sample for each cut and variable:
+	// {selections x variables x samples}
+	normHists [][][]float64
 
 	// Normalisation of total background (and signal if
-	// stacked) for each cut
-	normTotal []float64
+	// stacked) for each cut and variable
+	normTotal [][]float64
 
 	idxData     []int         // Indices of data samples in []*Sample slice
 	idxBkgs     []int         // Indices of bkg samples in []*Sample slice
 	idxSigs     []int         // Indices of sig samples in []*Sample slice
 	histoFilled bool          // true if histograms are filled.
+	histoLoaded bool          // true if histograms are loaded from a file.
 	nEvents     int64         // Number of processed events
 	timeLoop    time.Duration // Processing time for filling histograms (event loop over samples x cuts x histos)
 	timePlot    time.Duration // Processing time for plotting histogram
 }
 
 // New creates a default analysis maker from a list of sample
-// and a list of variables.
-func New(s []*Sample, v []*Variable, opts ...Options) Maker {
+// and a list of variables. An error is returned if a sample is
+// ill-defined, or if the variable types cannot be assessed.
+func New(s []*Sample, v []*Variable, opts ...Options) (Maker, error) {
+
+	// Create the configured object
+	a, err := newMaker(s, v, opts...)
+	if err != nil {
+		return a, err
+	}
+
+	// Build the slice of values to store
+	// FIX-ME(rmadar): this is not so clean to assess slice or not
+	//                 by doing a loop over variables for the first
+	//                 component of the first sample to fill v.isSlice.
+	if err := a.assessVariableTypes(); err != nil {
+		return a, err
+	}
+
+	return a, nil
+}
+
+// Helper function creating an analysis maker from a list of
+// samples, a list of variables and the options, without
+// reading any file.
+func newMaker(s []*Sample, v []*Variable, opts ...Options) (Maker, error) {
 
 	// Create the object
 	a := Maker{
@@ -92,6 +148,7 @@ func New(s []*Sample, v []*Variable, opts ...Options) Maker {
 		RatioPlot:      true,
 		TotalBand:      true,
 		TotalBandColor: color.NRGBA{A: 100},
+		FlowMode:       FlowHide,
 		KinemCuts:      []*Selection{EmptySelection()},
 		nVars:          len(v),
 	}
@@ -108,6 +165,15 @@ func New(s []*Sample, v []*Variable, opts ...Options) Maker {
 	if cfg.KinemCuts.usr {
 		a.KinemCuts = cfg.KinemCuts.val
 	}
+	if cfg.Variables2D.usr {
+		a.Variables2D = cfg.Variables2D.val
+	}
+	if cfg.Profiles.usr {
+		a.Profiles = cfg.Profiles.val
+	}
+	if cfg.CutFlowCuts.usr {
+		a.CutFlowCuts = cfg.CutFlowCuts.val
+	}
 	if cfg.NevtsMax.usr {
 		a.NevtsMax = cfg.NevtsMax.val
 	}
@@ -117,6 +183,9 @@ func New(s []*Sample, v []*Variable, opts ...Options) Maker {
 	if cfg.SampleMT.usr {
 		a.SampleMT = cfg.SampleMT.val
 	}
+	if cfg.NWorkers.usr {
+		a.NWorkers = cfg.NWorkers.val
+	}
 	if cfg.SavePath.usr {
 		a.SavePath = cfg.SavePath.val
 	}
@@ -126,6 +195,15 @@ func New(s []*Sample, v []*Variable, opts ...Options) Maker {
 	if cfg.DumpTree.usr {
 		a.DumpTree = cfg.DumpTree.val
 	}
+	if cfg.SaveHistos.usr {
+		a.SaveHistos = cfg.SaveHistos.val
+	}
+	if cfg.SaveYields.usr {
+		a.SaveYields = cfg.SaveYields.val
+	}
+	if cfg.Cache.usr {
+		a.Cache = cfg.Cache.val
+	}
 	if cfg.PlotHisto.usr {
 		a.PlotHisto = cfg.PlotHisto.val
 	}
@@ -156,25 +234,45 @@ func New(s []*Sample, v []*Variable, opts ...Options) Maker {
 	if cfg.TotalBandColor.usr {
 		a.TotalBandColor = cfg.TotalBandColor.val
 	}
+	if cfg.FlowMode.usr {
+		a.FlowMode = cfg.FlowMode.val
+	}
+
+	// Report errors met while declaring samples
+	for _, samp := range a.Samples {
+		if samp.err != nil {
+			return a, samp.err
+		}
+	}
+
+	// Report errors met while declaring variables
+	for _, v := range a.Variables {
+		if v.err != nil {
+			return a, v.err
+		}
+	}
+
+	// Check that the selections are saved in distinct directories
+	if err := a.checkSelectionNames(); err != nil {
+		return a, err
+	}
 
 	// Get ordered lists of background and signal names
-	a.idxData, a.idxBkgs, a.idxSigs = a.getSampleProc()
+	var err error
+	a.idxData, a.idxBkgs, a.idxSigs, err = a.getSampleProc()
+	if err != nil {
+		return a, err
+	}
 
 	// Managing event number with concurrency
 	a.nEvtsSample = make([]int64, len(a.Samples))
 
-	// Build the slice of values to store
-	// FIX-ME(rmadar): this is not so clean to assess slice or not
-	//                 by doing a loop over variables for the first
-	//                 component of the first sample to fill v.isSlice.
-	a.assessVariableTypes()
-
-	return a
+	return a, nil
 }
 
 // Helper function to get ordered list of background
 // and signal names
-func (ana *Maker) getSampleProc() ([]int, []int, []int) {
+func (ana *Maker) getSampleProc() ([]int, []int, []int, error) {
 	iData, iBkgs, iSigs := []int{}, []int{}, []int{}
 	for i, s := range ana.Samples {
 		switch s.sType {
@@ -188,14 +286,35 @@ func (ana *Maker) getSampleProc() ([]int, []int, []int) {
 	}
 
 	if len(iData) > 1 {
-		log.Fatalf("More than one data sample")
+		names := make([]string, len(iData))
+		for i, idx := range iData {
+			names[i] = ana.Samples[idx].Name
+		}
+		err := fmt.Errorf("%w: %v", ErrDataSamples, names)
+		return nil, nil, nil, &Error{Op: "sort samples", Err: err}
 	}
 
-	return iData, iBkgs, iSigs
+	return iData, iBkgs, iSigs, nil
+}
+
+// Helper function checking that no selection is named "all",
+// the directory of the selection without name, if any.
+func (ana *Maker) checkSelectionNames() error {
+	unnamed, reserved := false, false
+	for _, sel := range ana.KinemCuts {
+		unnamed = unnamed || sel.Name == ""
+		reserved = reserved || sel.Name == noSelDirName
+	}
+	if unnamed && reserved {
+		err := fmt.Errorf("%w: %q is used for the selection without name", ErrSelectionName, noSelDirName)
+		return &Error{Op: "check selections", Selection: noSelDirName, Err: err}
+	}
+	return nil
 }
 
 // PrintReport prints some general information about the number
-// of processed samples, events and produced histograms.
+// of processed samples, events and produced histograms. The events
+// and the timing are not printed for histograms loaded from a file.
 func (ana Maker) PrintReport() {
 
 	// Event and histo info
@@ -205,12 +324,22 @@ func (ana Maker) PrintReport() {
 			nfiles++
 		}
 	}
-	nvars, ncuts := len(ana.Variables), len(ana.KinemCuts)
+	nvars := len(ana.Variables) + len(ana.Variables2D) + len(ana.Profiles)
+	ncuts := len(ana.KinemCuts)
 	nhist := nvars * nfiles
 	if ncuts > 0 {
 		nhist *= ncuts
 	}
 
+	// Histograms loaded from a file, without events nor timing.
+	if ana.histoLoaded {
+		fmt.Printf("\n Processing report:\n"+
+			"    - %v histograms loaded (%v files, %v variables, %v selections)\n\n",
+			nhist, nfiles, nvars, ncuts,
+		)
+		return
+	}
+
 	// Time computation
 	nkevt := float64(ana.nEvents) / 1e3
 	dtLoop := float64(ana.timeLoop) / float64(time.Millisecond)
@@ -232,7 +361,9 @@ func (ana Maker) PrintReport() {
 // PrintSlowTreeFuncs prints the list of TreeFunc which relies on
 // a generic groot/rfunc formula, ie based on 'reflect' calls. These
 // function are ~ 5 times slower than the one defined using this example
-// https://godoc.org/go-hep.org/x/hep/groot/rtree#example-Reader--WithFormulaFromUser
+// https://godoc.org/go-hep.org/x/hep/groot/rtree#example-Reader--WithFormulaFromUser,
+// which can be registered for their type with RegisterFormula, or
+// by importing package ana/allfuncs.
 func (ana *Maker) PrintSlowTreeFuncs() {
 
 	appendSlow := func(fs *[]TreeFunc, f TreeFunc) {
@@ -248,6 +379,14 @@ func (ana *Maker) PrintSlowTreeFuncs() {
 	for _, v := range ana.Variables {
 		appendSlow(slowFs, v.TreeFunc)
 	}
+	for _, v := range ana.Variables2D {
+		appendSlow(slowFs, v.XTreeFunc)
+		appendSlow(slowFs, v.YTreeFunc)
+	}
+	for _, p := range ana.Profiles {
+		appendSlow(slowFs, p.XTreeFunc)
+		appendSlow(slowFs, p.YTreeFunc)
+	}
 
 	// Kinematic cuts.
 	for _, c := range ana.KinemCuts {
@@ -258,9 +397,17 @@ func (ana *Maker) PrintSlowTreeFuncs() {
 	for _, s := range ana.Samples {
 		appendSlow(slowFs, s.CutFunc)
 		appendSlow(slowFs, s.WeightFunc)
+		for _, syst := range s.systs {
+			appendSlow(slowFs, syst.Up)
+			appendSlow(slowFs, syst.Down)
+		}
 		for _, c := range s.components {
 			appendSlow(slowFs, c.CutFunc)
 			appendSlow(slowFs, c.WeightFunc)
+			for _, syst := range c.systs {
+				appendSlow(slowFs, syst.Up)
+				appendSlow(slowFs, syst.Down)
+			}
 		}
 	}
 
@@ -286,9 +433,16 @@ func (ana *Maker) RunTimePerKEvts() float64 {
 // Run performs the three steps in one function: fill histos, plot histos
 // and print report.
 func (ana *Maker) Run() error {
+	return ana.RunContext(context.Background())
+}
+
+// RunContext performs the same steps as Run, but the event loops
+// are stopped as soon as the context is done. In that case, nothing
+// is plotted and the returned error is an *InterruptError.
+func (ana *Maker) RunContext(ctx context.Context) error {
 
 	// Create histograms via event loops
-	err := ana.RunEventLoops()
+	err := ana.RunEventLoopsContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -26,6 +26,10 @@ type config struct {
 		val []*Selection // List of cuts.
 		usr bool
 	}
+	CutFlowCuts struct {
+		val []*Selection // List of cumulative cuts.
+		usr bool
+	}
 	NevtsMax struct {
 		val int64 // Maximum of processed events.
 		usr bool
@@ -38,6 +42,18 @@ type config struct {
 		val bool // Enable concurency over samples.
 		usr bool
 	}
+	NWorkers struct {
+		val int // Number of concurrent workers over entry ranges.
+		usr bool
+	}
+	Variables2D struct {
+		val []*Variable2D // List of two-dimensional variables.
+		usr bool
+	}
+	Profiles struct {
+		val []*Profile // List of profiles.
+		usr bool
+	}
 	SavePath struct {
 		val string // Path to which plot will be saved.
 		usr bool
@@ -54,6 +70,18 @@ type config struct {
 		val bool // Enable Tree dumping
 		usr bool
 	}
+	SaveHistos struct {
+		val string // Name of the ROOT file of histograms
+		usr bool
+	}
+	SaveYields struct {
+		val bool // Enable the saving of yield tables
+		usr bool
+	}
+	Cache struct {
+		val bool // Enable the histogram cache
+		usr bool
+	}
 	PlotHisto struct {
 		val bool // Enable histograms plotting
 		usr bool
@@ -90,6 +118,10 @@ type config struct {
 		val color.NRGBA // Color for the uncertainty band.
 		usr bool
 	}
+	FlowMode struct {
+		val FlowMode // Under/overflow handling.
+		usr bool
+	}
 
 	// Sample options
 	WeightFunc struct {
@@ -104,6 +136,10 @@ type config struct {
 		val []input // slice of file/tree name of joints trees
 		usr bool
 	}
+	Systematics struct {
+		val []systematic // systematic variations
+		usr bool
+	}
 	Xsec struct {
 		val float64 // cross-section of this sample/component
 		usr bool
@@ -214,6 +250,22 @@ type config struct {
 		val float64 // Y-axis ranges
 		usr bool
 	}
+	VarFlowMode struct {
+		val FlowMode // Under/overflow handling of a variable
+		usr bool
+	}
+	BinEdges struct {
+		val []float64 // Variable-width binning
+		usr bool
+	}
+	RatioZmin struct {
+		val float64
+		usr bool
+	}
+	RatioZmax struct {
+		val float64 // Color scale range of ratio maps
+		usr bool
+	}
 	LegPosTop struct {
 		val bool
 		usr bool
@@ -269,6 +321,37 @@ func WithSampleMT(b bool) Options {
 	}
 }
 
+// WithNWorkers sets the number of concurrent workers processing
+// the events. If n>0, each sample component is split in entry ranges
+// which are scheduled on a pool of n workers, whatever the sample
+// it belongs to. This balances the load when a sample is much larger
+// than the others. If n<=0, the concurrency is over samples only,
+// as set by WithSampleMT().
+func WithNWorkers(n int) Options {
+	return func(cfg *config) {
+		cfg.NWorkers.val = n
+		cfg.NWorkers.usr = true
+	}
+}
+
+// WithVariables2D sets the list of two-dimensional variables
+// to histogram, for each sample and selection.
+func WithVariables2D(v []*Variable2D) Options {
+	return func(cfg *config) {
+		cfg.Variables2D.val = v
+		cfg.Variables2D.usr = true
+	}
+}
+
+// WithProfiles sets the list of profiles to fill and
+// plot, for each sample and selection.
+func WithProfiles(p []*Profile) Options {
+	return func(cfg *config) {
+		cfg.Profiles.val = p
+		cfg.Profiles.usr = true
+	}
+}
+
 // WithSavePath sets the path to save plots.
 func WithSavePath(p string) Options {
 	return func(cfg *config) {
@@ -301,6 +384,68 @@ func WithDumpTree(b bool) Options {
 	}
 }
 
+// WithSaveHistos saves all the histograms filled by the event
+// loops in the ROOT file SavePath/fname, with one directory per
+// selection ("all" for a selection without name, which is then
+// a reserved name). Histograms are named <sample>__<variable>,
+// and <sample>__<variable>__<syst>Up (or Down) for systematic
+// variations. Profiles are saved as the histograms of the sums
+// of their weights, <sample>__<profile>__SumW, __SumWY and
+// __SumWY2 (see NewFromHistos). They are not normalized and
+// include the underflow and the overflow.
+func WithSaveHistos(fname string) Options {
+	return func(cfg *config) {
+		cfg.SaveHistos.val = fname
+		cfg.SaveHistos.usr = true
+	}
+}
+
+// WithCutFlow sets the selections applied cumulatively, in this
+// order, to count the cut flow of all samples in the event loops.
+// The cut-flow table is returned by Maker.CutFlow, and always saved
+// in SavePath by Maker.RunEventLoops as cutflow.txt (ASCII),
+// cutflow.md (Markdown), cutflow.tex (LaTeX) and cutflow.csv (CSV).
+// RunCutFlows, which sets the cuts itself, doesn't save them.
+func WithCutFlow(cuts []*Selection) Options {
+	return func(cfg *config) {
+		cfg.CutFlowCuts.val = cuts
+		cfg.CutFlowCuts.usr = true
+	}
+}
+
+// WithSaveYields saves the yield tables of all samples and
+// selections in SavePath, once the event loops are done, as
+// yields.txt (ASCII), yields.md (Markdown), yields.tex (LaTeX)
+// and yields.csv (CSV). See Maker.Yields for their content.
+func WithSaveYields(b bool) Options {
+	return func(cfg *config) {
+		cfg.SaveYields.val = b
+		cfg.SaveYields.usr = true
+	}
+}
+
+// WithCache enables the cache of the histograms filled for each
+// sample component, in SavePath/.cache. A component is read again
+// only for the histograms which are not cached yet, e.g. a new
+// variable. Cached histograms are identified by the files of the
+// component (path, size and modification time), NevtsMax, Lumi,
+// the normalization, the cuts and the weights of the component
+// and its sample, the selection, the variable and its binning,
+// and the systematic variations. TreeFunc's are identified by
+// their branches and the source code of their function. They are
+// never cached if their function uses identifiers declared outside
+// of it, other than those of the standard library, eg captured
+// variables or helper functions. Neither are they if its source
+// code cannot be found, or if they are defined with a Formula.
+// The cache is not used when trees are dumped, or histograms
+// not plotted.
+func WithCache(b bool) Options {
+	return func(cfg *config) {
+		cfg.Cache.val = b
+		cfg.Cache.usr = true
+	}
+}
+
 // WithPlotHisto enables histogram plotting. It can be
 // set to false to only dump trees.
 func WithPlotHisto(b bool) Options {
@@ -378,6 +523,17 @@ func WithTotalBandColor(c color.NRGBA) Options {
 	}
 }
 
+// WithFlowMode sets how the underflow and the overflow
+// of variables are handled: hidden, folded into the edge bins,
+// or shown as extra bins. Normalized histograms have unit area
+// over the plotted range.
+func WithFlowMode(m FlowMode) Options {
+	return func(cfg *config) {
+		cfg.FlowMode.val = m
+		cfg.FlowMode.usr = true
+	}
+}
+
 // WithWeight sets the weight to be used for this sample,
 // as defined by the TreeFunc f, which must return a float64.
 // Maker.FillHisto() will panic otherwise.
@@ -409,6 +565,51 @@ func WithJointTree(fname, tname string) SampleOptions {
 	}
 }
 
+// WithSystematic adds a systematic variation to the sample/component,
+// defined by the up and down weight factors, which must return a
+// float64. They multiply the nominal weight of the events to fill
+// the varied histograms, in the same event loop. Variations with the
+// same name, defined for a sample and its components, are treated as
+// a single source and multiplied. Several systematic variations can
+// be added using WithSystematic() option several times. This option
+// cannot be passed to a sample (or component) of type "data".
+func WithSystematic(name string, up, down TreeFunc) SampleOptions {
+	return func(cfg *config) {
+		s := systematic{Name: name, Up: up, Down: down}
+		cfg.Systematics.val = append(cfg.Systematics.val, s)
+		cfg.Systematics.usr = true
+	}
+}
+
+// WithTreeSystematic adds a systematic variation to the sample/component,
+// for which the events are read from the up and down alternative trees,
+// in the same file as the nominal one. An empty tree name stands for the
+// nominal tree. Each variation is read in a separate pass over the events,
+// filling only the histograms of variables. This option cannot be passed to
+// a sample (or component) of type "data".
+func WithTreeSystematic(name, upTree, downTree string) SampleOptions {
+	return func(cfg *config) {
+		s := systematic{Name: name, Kind: treeSyst, UpTree: upTree, DownTree: downTree}
+		cfg.Systematics.val = append(cfg.Systematics.val, s)
+		cfg.Systematics.usr = true
+	}
+}
+
+// WithBranchSystematic adds a systematic variation to the sample/component,
+// for which the branches are renamed according to the up and down maps, in
+// the VarsName of all TreeFunc's (variables, cuts, weights and selections).
+// TreeFunc's defined with a Formula are not renamed. Each variation is read
+// in a separate pass over the events, filling only the histograms of
+// variables. This option cannot be passed to a sample (or component)
+// of type "data".
+func WithBranchSystematic(name string, up, down map[string]string) SampleOptions {
+	return func(cfg *config) {
+		s := systematic{Name: name, Kind: branchSyst, UpBranches: up, DownBranches: down}
+		cfg.Systematics.val = append(cfg.Systematics.val, s)
+		cfg.Systematics.usr = true
+	}
+}
+
 // WithXsec sets the cross-section in pb to the sample/component.
 // The full normalisation factor is (xsec*lumi)/ngen. 'ngen' and
 // 'xsec' are given by sample/component while 'lumi' is given to
@@ -605,6 +806,35 @@ func WithRatioYRange(min, max float64) VariableOptions {
 	}
 }
 
+// WithBinEdges sets the edges of variable-width bins,
+// overriding the number of bins and the histogram range.
+func WithBinEdges(edges []float64) VariableOptions {
+	return func(cfg *config) {
+		cfg.BinEdges.val = edges
+		cfg.BinEdges.usr = true
+	}
+}
+
+// WithVarFlowMode sets the under/overflow handling of the
+// variable, overriding the one of the analysis.
+func WithVarFlowMode(m FlowMode) VariableOptions {
+	return func(cfg *config) {
+		cfg.VarFlowMode.val = m
+		cfg.VarFlowMode.usr = true
+	}
+}
+
+// WithRatioZRange sets the color scale min and max for
+// the ratio maps of two-dimensional variables.
+func WithRatioZRange(min, max float64) VariableOptions {
+	return func(cfg *config) {
+		cfg.RatioZmin.val = min
+		cfg.RatioZmin.usr = true
+		cfg.RatioZmax.val = max
+		cfg.RatioZmax.usr = true
+	}
+}
+
 // WithLegLeft sets the legend left/right position on the plot.
 func WithLegLeft(left bool) VariableOptions {
 	return func(cfg *config) {
@@ -170,7 +170,7 @@ func runTest(n10kEvtsPerSample, nVariables int) float64 {
 	}
 
 	// Create analyzer object with options
-	analyzer := ana.New(samples, variables[:nVars],
+	analyzer, err := ana.New(samples, variables[:nVars],
 		//ana.WithDumpTree(true),
 		//ana.WithPlotHisto(false),
 		//ana.WithSaveFormat("tex"),
@@ -179,6 +179,9 @@ func runTest(n10kEvtsPerSample, nVariables int) float64 {
 		ana.WithHistoStack(true),
 		ana.WithSampleMT(true),
 	)
+	if err != nil {
+		log.Fatal("Cannot create analyzer:", err)
+	}
 
 	// Run the analyzer and produce all plots
 	if err := analyzer.RunEventLoops(); err != nil {
@@ -0,0 +1,174 @@
+package ana
+
+import (
+	"math"
+
+	"go-hep.org/x/hep/hbook"
+	"go-hep.org/x/hep/hplot"
+)
+
+// Profile contains the information needed to fill and plot
+// the weighted mean of a y-variable, in bins of a x-variable,
+// as ROOT TProfile. The two TreeFunc objects must either both
+// return a float64, or both return a []float64. In the latter
+// case, the elements of the two slices are paired by index.
+// Profiles are not included in dumped trees.
+type Profile struct {
+	Name                     string   // Profile name.
+	XTreeFunc, YTreeFunc     TreeFunc // Variable definitions from branches & functions.
+	Nbins                    int      // Number of bins along x.
+	Xmin, Xmax               float64  // Mininum and maximum values along x.
+	SaveName                 string   // Name of the saved plot (default 'Name').
+	XLabel, YLabel           string   // Axis labels (default: 'Variable', 'Mean').
+	XTickFormat, YTickFormat string   // Axis tick formatting (default: hplot default).
+	RangeXmin, RangeXmax     float64  // X-axis range (default: hplot default).
+	RangeYmin, RangeYmax     float64  // Y-axis range (default: hplot default).
+	RatioYmin, RatioYmax     float64  // Ratio Y-axis range (default: hplot default).
+	LegPosTop, LegPosLeft    bool     // Legend position (default: true, false)
+	isSlice                  bool
+}
+
+// NewProfile creates a new profile with default settings.
+// xFunc and yFunc define the values along x and y, and must
+// both return either a float64 or a []float64. The options
+// WithLogY and WithRatioZRange are ignored.
+func NewProfile(name string, xFunc, yFunc TreeFunc, nBins int, xMin, xMax float64, opts ...VariableOptions) *Profile {
+
+	// Create the object
+	p := &Profile{
+		Name:      name,
+		XTreeFunc: xFunc,
+		YTreeFunc: yFunc,
+		Nbins:     nBins,
+		Xmin:      xMin,
+		Xmax:      xMax,
+		SaveName:  name,
+		XLabel:    `Variable`,
+		YLabel:    `Mean`,
+		LegPosTop: true,
+	}
+
+	// Configuration with default values for all optional fields
+	cfg := newConfig()
+
+	// Update the configuration looping over functional options
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	// Set fields with updaded configuration
+	if cfg.SaveName.usr {
+		p.SaveName = cfg.SaveName.val
+	}
+	if cfg.XLabel.usr {
+		p.XLabel = cfg.XLabel.val
+	}
+	if cfg.YLabel.usr {
+		p.YLabel = cfg.YLabel.val
+	}
+	if cfg.XTickFormat.usr {
+		p.XTickFormat = cfg.XTickFormat.val
+	}
+	if cfg.YTickFormat.usr {
+		p.YTickFormat = cfg.YTickFormat.val
+	}
+	if cfg.RangeXmin.usr {
+		p.RangeXmin = cfg.RangeXmin.val
+	}
+	if cfg.RangeXmax.usr {
+		p.RangeXmax = cfg.RangeXmax.val
+	}
+	if cfg.RangeYmin.usr {
+		p.RangeYmin = cfg.RangeYmin.val
+	}
+	if cfg.RangeYmax.usr {
+		p.RangeYmax = cfg.RangeYmax.val
+	}
+	if cfg.RatioYmin.usr {
+		p.RatioYmin = cfg.RatioYmin.val
+	}
+	if cfg.RatioYmax.usr {
+		p.RatioYmax = cfg.RatioYmax.val
+	}
+	if cfg.LegPosTop.usr {
+		p.LegPosTop = cfg.LegPosTop.val
+	}
+	if cfg.LegPosLeft.usr {
+		p.LegPosLeft = cfg.LegPosLeft.val
+	}
+	return p
+}
+
+// SetPlotStyle sets the user-specified style on
+// the hplot.Plot value, as for a Variable.
+func (p Profile) setPlotStyle(plt *hplot.Plot) {
+	v := Variable{
+		XLabel:      p.XLabel,
+		YLabel:      p.YLabel,
+		XTickFormat: p.XTickFormat,
+		YTickFormat: p.YTickFormat,
+		RangeXmin:   p.RangeXmin,
+		RangeXmax:   p.RangeXmax,
+		RangeYmin:   p.RangeYmin,
+		RangeYmax:   p.RangeYmax,
+		LegPosTop:   p.LegPosTop,
+		LegPosLeft:  p.LegPosLeft,
+	}
+	v.setPlotStyle(plt)
+}
+
+// profileHistos stores, in each x bin, the sums needed to
+// compute the mean of y and its uncertainty: the sums of
+// w (and w^2), of w*y and of w*y^2.
+type profileHistos struct {
+	w, wy, wy2 *hbook.H1D
+}
+
+func newProfileHistos(n int, xmin, xmax float64) profileHistos {
+	return profileHistos{
+		w:   hbook.NewH1D(n, xmin, xmax),
+		wy:  hbook.NewH1D(n, xmin, xmax),
+		wy2: hbook.NewH1D(n, xmin, xmax),
+	}
+}
+
+// fill fills the profile with the value y at x, with weight w.
+func (p profileHistos) fill(x, y, w float64) {
+	p.w.Fill(x, w)
+	p.wy.Fill(x, w*y)
+	p.wy2.Fill(x, w*y*y)
+}
+
+// add returns the sum of the two profiles.
+func (p profileHistos) add(o profileHistos) profileHistos {
+	return profileHistos{
+		w:   hbook.AddH1D(p.w, o.w),
+		wy:  hbook.AddH1D(p.wy, o.wy),
+		wy2: hbook.AddH1D(p.wy2, o.wy2),
+	}
+}
+
+// points returns, for each non-empty x bin, the weighted
+// mean of y and its uncertainty, computed as the spread of y
+// over the square root of the effective number of entries.
+func (p profileHistos) points() []hbook.Point2D {
+	pts := []hbook.Point2D{}
+	for i, b := range p.w.Binning.Bins {
+		sumw, sumw2 := b.SumW(), b.SumW2()
+		if sumw == 0 {
+			continue
+		}
+		mean := p.wy.Binning.Bins[i].SumW() / sumw
+		variance := math.Max(p.wy2.Binning.Bins[i].SumW()/sumw-mean*mean, 0)
+		nEff := sumw * sumw / sumw2
+		dx := 0.5 * b.XWidth()
+		dy := math.Sqrt(variance / nEff)
+		pts = append(pts, hbook.Point2D{
+			X:    b.XMid(),
+			Y:    mean,
+			ErrX: hbook.Range{Min: dx, Max: dx},
+			ErrY: hbook.Range{Min: dy, Max: dy},
+		})
+	}
+	return pts
+}
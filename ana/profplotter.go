@@ -0,0 +1,197 @@
+package ana
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"sync"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+
+	"go-hep.org/x/hep/hbook"
+	"go-hep.org/x/hep/hplot"
+	"go-hep.org/x/hep/hplot/htex"
+
+	"github.com/rmadar/hplot-style/style"
+)
+
+func (ana *Maker) concurrentPlotProfile(iProf, iCut int, latex htex.Handler, err *error, wg *sync.WaitGroup) {
+
+	// Handle concurrency
+	defer wg.Done()
+
+	// Plot the profiles
+	if e := ana.plotProfile(iProf, iCut, latex); e != nil {
+		*err = &Error{
+			Op:        "plot profile",
+			Variable:  ana.Profiles[iProf].Name,
+			Selection: ana.KinemCuts[iCut].Name,
+			Err:       e,
+		}
+	}
+}
+
+// plotProfile overlays the profiles of all samples as points
+// with error bars. The ratio plot shows each sample over the
+// data, or over the first sample if there is no data.
+func (ana *Maker) plotProfile(iProf, iCut int, latex htex.Handler) error {
+
+	// Current profile
+	p := ana.Profiles[iProf]
+
+	var (
+		drw       hplot.Drawer
+		plt       = hplot.New()
+		figWidth  = 6 * vg.Inch
+		figHeight = 4.5 * vg.Inch
+	)
+
+	// Mean values of each sample
+	pts := make([][]hbook.Point2D, len(ana.Samples))
+	for i := range ana.Samples {
+		pts[i] = ana.hbookProfiles[i][iCut][iProf].points()
+	}
+
+	// Points with error bars, data being drawn last
+	idx := append(append(append([]int{}, ana.idxBkgs...), ana.idxSigs...), ana.idxData...)
+	for _, i := range idx {
+		if len(pts[i]) == 0 {
+			continue
+		}
+		s := ana.Samples[i]
+		ps := ana.newProfileS2D(s, pts[i])
+		plt.Add(ps)
+		plt.Legend.Add(s.LegLabel, ps)
+	}
+
+	// Apply common and user-defined style for this profile
+	plt.Title.Text = ana.PlotTitle
+	style.ApplyToPlot(plt)
+	p.setPlotStyle(plt)
+	drw = plt
+
+	// Addition of the ratio plot
+	if ana.RatioPlot && len(ana.Samples) > 1 {
+
+		// Create a ratio plot and style it using plt
+		rp := hplot.NewRatioPlot()
+		style.ApplyToRatioPlot(rp, plt)
+		drw = rp
+
+		// Reference sample
+		iRef := 0
+		if len(ana.idxData) > 0 {
+			iRef = ana.idxData[0]
+		}
+
+		// Ratios of the other samples
+		for _, i := range idx {
+			if i == iRef {
+				continue
+			}
+			ratio := profileRatio(pts[i], pts[iRef])
+			if len(ratio) == 0 {
+				continue
+			}
+			rp.Bottom.Add(ana.newProfileS2D(ana.Samples[i], ratio))
+		}
+
+		// Adjust ratio plot scale
+		if p.RatioYmin != p.RatioYmax {
+			rp.Bottom.Y.Min = p.RatioYmin
+			rp.Bottom.Y.Max = p.RatioYmax
+		}
+	}
+
+	// Create the figure
+	f := hplot.Figure(drw)
+	style.ApplyToFigure(f)
+	f.Latex = latex
+
+	// Save the figure
+	path := ana.SavePath + "/" + ana.KinemCuts[iCut].Name
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return fmt.Errorf("could not create output directory: %w", err)
+		}
+	}
+	outputname := path + "/" + p.SaveName + "." + ana.SaveFormat
+	if err := hplot.Save(f, figWidth, figHeight, outputname); err != nil {
+		return fmt.Errorf("could not save plot: %w", err)
+	}
+
+	return nil
+}
+
+// Helper function creating points with error bars,
+// with the color of the sample.
+func (ana *Maker) newProfileS2D(s *Sample, pts []hbook.Point2D) *hplot.S2D {
+
+	ps := hplot.NewS2D(hbook.NewS2D(pts...),
+		hplot.WithXErrBars(true),
+		hplot.WithYErrBars(true),
+	)
+
+	// Data style
+	if s.DataStyle {
+		style.ApplyToDataS2D(ps)
+		ps.XErrs.LineStyle = ps.YErrs.LineStyle
+		ps.XErrs.CapWidth = 0
+		return ps
+	}
+
+	// Sample color, from the line or the fill
+	c := s.LineColor
+	if c == colorNil {
+		c = s.FillColor
+	}
+	ps.LineStyle.Width = 0
+	ps.GlyphStyle = draw.GlyphStyle{
+		Shape:  draw.BoxGlyph{},
+		Color:  c,
+		Radius: vg.Points(2.5),
+	}
+	for _, errs := range []*draw.LineStyle{&ps.XErrs.LineStyle, &ps.YErrs.LineStyle} {
+		errs.Color = c
+		errs.Width = 1.5
+	}
+	ps.XErrs.CapWidth = 0
+	ps.YErrs.CapWidth = 5
+
+	return ps
+}
+
+// Helper function computing the ratio of two profiles, for
+// the x bins present in both, propagating the uncertainties
+// as uncorrelated.
+func profileRatio(num, den []hbook.Point2D) []hbook.Point2D {
+	pts := []hbook.Point2D{}
+	j := 0
+	for _, pn := range num {
+		for j < len(den) && den[j].X < pn.X {
+			j++
+		}
+		if j == len(den) || den[j].X != pn.X || den[j].Y == 0 {
+			continue
+		}
+		pd := den[j]
+		r := pn.Y / pd.Y
+		dr := math.Abs(r) * math.Hypot(relErr(pn), relErr(pd))
+		pts = append(pts, hbook.Point2D{
+			X:    pn.X,
+			Y:    r,
+			ErrX: pn.ErrX,
+			ErrY: hbook.Range{Min: dr, Max: dr},
+		})
+	}
+	return pts
+}
+
+// Helper function returning the relative y-error of a point.
+func relErr(p hbook.Point2D) float64 {
+	if p.Y == 0 {
+		return 0
+	}
+	return p.ErrY.Max / p.Y
+}
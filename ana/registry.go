@@ -0,0 +1,53 @@
+package ana
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Registry of the named TreeFunc's, used in configuration files.
+var treeFuncs = struct {
+	sync.RWMutex
+	m map[string]TreeFunc
+}{m: make(map[string]TreeFunc)}
+
+// RegisterTreeFunc registers a TreeFunc under a name, so that it can
+// be used in configuration files (see NewFromConfig). An error is
+// returned if the name is empty or already registered.
+func RegisterTreeFunc(name string, f TreeFunc) error {
+	if name == "" {
+		return fmt.Errorf("%w: empty name", ErrRegistry)
+	}
+	treeFuncs.Lock()
+	defer treeFuncs.Unlock()
+	if _, dup := treeFuncs.m[name]; dup {
+		return fmt.Errorf("%w: %q already registered", ErrRegistry, name)
+	}
+	treeFuncs.m[name] = f
+	return nil
+}
+
+// RegisteredTreeFuncs returns the sorted names of
+// all registered TreeFunc's.
+func RegisteredTreeFuncs() []string {
+	treeFuncs.RLock()
+	defer treeFuncs.RUnlock()
+	names := make([]string, 0, len(treeFuncs.m))
+	for name := range treeFuncs.m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Helper function returning the TreeFunc registered under a name.
+func registeredTreeFunc(name string) (TreeFunc, error) {
+	treeFuncs.RLock()
+	defer treeFuncs.RUnlock()
+	f, ok := treeFuncs.m[name]
+	if !ok {
+		return TreeFunc{}, fmt.Errorf("%w: %q not registered", ErrRegistry, name)
+	}
+	return f, nil
+}
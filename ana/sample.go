@@ -2,7 +2,6 @@ package ana
 
 import (
 	"fmt"
-	"log"
 	"strings"
 
 	"image/color"
@@ -61,8 +60,10 @@ type Sample struct {
 
 	// Internal
 	components []*sampleComponent
+	systs      []systematic // Systematic variations of all components.
 	sType      sampleType
 	config     *config
+	err        error // First error met while declaring the sample.
 }
 
 // SampleComponent contains the needed information
@@ -77,6 +78,7 @@ type sampleComponent struct {
 	CutFunc    TreeFunc
 	Xsec       float64
 	Ngen       float64
+	systs      []systematic
 }
 
 // Helper structure to describe JointTrees
@@ -92,6 +94,7 @@ func NewSample(sname, stype, sleg string, opts ...SampleOptions) *Sample {
 
 	// Check & set sample type
 	var sType sampleType
+	var err error
 	switch strings.ToLower(stype) {
 	case "data":
 		sType = data
@@ -100,8 +103,11 @@ func NewSample(sname, stype, sleg string, opts ...SampleOptions) *Sample {
 	case "signal", "sig", "sg":
 		sType = sig
 	default:
-		err := "sample type \"%v\" not supported [sample %v]"
-		log.Fatal(fmt.Sprintf(err, stype, sname))
+		err = &Error{
+			Op:     "create sample",
+			Sample: sname,
+			Err:    fmt.Errorf("%w: %q", ErrSampleType, stype),
+		}
 	}
 
 	// Empty basic sample with default setup
@@ -114,6 +120,7 @@ func NewSample(sname, stype, sleg string, opts ...SampleOptions) *Sample {
 		sType:      sType,
 		DataStyle:  sType == data,
 		YErrBars:   sType == data,
+		err:        err,
 	}
 
 	// Configuration with defaults values for all optional fields
@@ -130,6 +137,15 @@ func NewSample(sname, stype, sleg string, opts ...SampleOptions) *Sample {
 	// Apply the configuration
 	s.applyConfig()
 
+	// Systematic variations are not supported for data
+	if cfg.Systematics.usr && sType == data && s.err == nil {
+		s.err = &Error{
+			Op:     "create sample",
+			Sample: sname,
+			Err:    fmt.Errorf("%w: systematic", ErrDataOption),
+		}
+	}
+
 	return s
 }
 
@@ -142,6 +158,9 @@ func (s *Sample) applyConfig() {
 	if s.config.CutFunc.usr {
 		s.CutFunc = s.config.CutFunc.val
 	}
+	if s.config.Systematics.usr {
+		s.systs = s.config.Systematics.val
+	}
 	if s.config.LineColor.usr {
 		s.LineColor = s.config.LineColor.val
 	}
@@ -214,13 +233,13 @@ func CreateSample(sname, stype, sleg, fname, tname string, opts ...SampleOptions
 
 	if cfg.Xsec.usr {
 		if s.sType == data {
-			log.Fatalf("sample type 'data' doesn't support xsection option.")
+			s.setErr(s.components[0], fmt.Errorf("%w: xsection", ErrDataOption))
 		}
 		s.components[0].Xsec = cfg.Xsec.val
 	}
 	if cfg.Ngen.usr {
 		if s.sType == data {
-			log.Fatalf("sample type 'data' doesn't support Ngen option.")
+			s.setErr(s.components[0], fmt.Errorf("%w: Ngen", ErrDataOption))
 		}
 		s.components[0].Ngen = cfg.Ngen.val
 	}
@@ -258,23 +277,47 @@ func (s *Sample) AddComponent(fname, tname string, opts ...SampleOptions) {
 	if cfg.CutFunc.usr {
 		c.CutFunc = cfg.CutFunc.val
 	}
+	if cfg.JointTrees.usr {
+		c.JointTrees = cfg.JointTrees.val
+	}
 	if cfg.Xsec.usr {
 		if s.sType == data {
-			log.Fatalf("component of type 'data' sample doesn't support xsection option.")
+			s.setErr(c, fmt.Errorf("%w: xsection", ErrDataOption))
 		}
 		c.Xsec = cfg.Xsec.val
 	}
 	if cfg.Ngen.usr {
 		if s.sType == data {
-			log.Fatalf("component of type 'data' sample doesn't support Ngen option.")
+			s.setErr(c, fmt.Errorf("%w: Ngen", ErrDataOption))
 		}
 		c.Ngen = cfg.Ngen.val
 	}
+	if cfg.Systematics.usr {
+		if s.sType == data {
+			s.setErr(c, fmt.Errorf("%w: systematic", ErrDataOption))
+		}
+		c.systs = cfg.Systematics.val
+	}
 
 	// Append it to the pointer-receiver sample
 	s.components = append(s.components, c)
 }
 
+// Helper function keeping track of the first error
+// met while declaring the sample component c.
+func (s *Sample) setErr(c *sampleComponent, err error) {
+	if s.err != nil {
+		return
+	}
+	s.err = &Error{
+		Op:     "add component",
+		Sample: s.Name,
+		File:   c.FileName,
+		Tree:   c.TreeName,
+		Err:    err,
+	}
+}
+
 // CreateHisto returns a hplot.H1D with the sample style.
 func (s Sample) CreateHisto(hdata *hbook.H1D, opts ...hplot.Options) *hplot.H1D {
 
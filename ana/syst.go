@@ -0,0 +1,255 @@
+package ana
+
+import (
+	"fmt"
+	"math"
+
+	"go-hep.org/x/hep/groot/rtree"
+	"go-hep.org/x/hep/hbook"
+)
+
+// Kind of systematic variation
+type systKind int
+
+const (
+	weightSyst systKind = iota // Factors applied to the nominal weight.
+	treeSyst                   // Alternative trees.
+	branchSyst                 // Alternative branches.
+)
+
+// systematic is a systematic variation, defined either by the
+// factors applied to the nominal weight of the events, or by
+// alternative trees or branches to read the events from.
+type systematic struct {
+	Name                     string
+	Kind                     systKind
+	Up, Down                 TreeFunc          // Weight factors.
+	UpTree, DownTree         string            // Alternative tree names.
+	UpBranches, DownBranches map[string]string // Branch name substitutions.
+}
+
+// Helper function returning the name of the tree to read
+// for the up (or down) variation, empty for the nominal one.
+func (s systematic) tree(up bool) string {
+	if up {
+		return s.UpTree
+	}
+	return s.DownTree
+}
+
+// Helper function returning the branch name substitutions
+// of the up (or down) variation.
+func (s systematic) branches(up bool) map[string]string {
+	if up {
+		return s.UpBranches
+	}
+	return s.DownBranches
+}
+
+// systHistos contains the histograms h[iCut][iVar] filled
+// with the up and down variations of a systematic. They
+// are nil if the sample has no such variation.
+type systHistos struct {
+	up, down [][]*hbook.H1D
+}
+
+// Helper function returning the names of all systematic
+// variations, in the order they are first declared.
+func (ana *Maker) systematicNames() []string {
+	names := []string{}
+	seen := make(map[string]bool)
+	add := func(systs []systematic) {
+		for _, s := range systs {
+			if !seen[s.Name] {
+				seen[s.Name] = true
+				names = append(names, s.Name)
+			}
+		}
+	}
+	for _, samp := range ana.Samples {
+		add(samp.systs)
+		for _, comp := range samp.components {
+			add(comp.systs)
+		}
+	}
+	return names
+}
+
+// Helper function returning true if the systematic
+// variation is defined for the sample or one of its
+// components.
+func (s *Sample) hasSystematic(name string) bool {
+	for _, syst := range s.systs {
+		if syst.Name == name {
+			return true
+		}
+	}
+	for _, comp := range s.components {
+		for _, syst := range comp.systs {
+			if syst.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Helper function returning true if the sample or
+// one of its components has a systematic variation.
+func (s *Sample) hasSystematics() bool {
+	if len(s.systs) > 0 {
+		return true
+	}
+	for _, comp := range s.components {
+		if len(comp.systs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper function returning the tree-based or branch-based
+// definition of the systematic k for a component, looking
+// at the component first and then at its sample.
+func (ana *Maker) variedSystematic(samp *Sample, comp *sampleComponent, k int) (systematic, bool) {
+	for _, systs := range [][]systematic{comp.systs, samp.systs} {
+		for _, syst := range systs {
+			if syst.Name == ana.systNames[k] && syst.Kind != weightSyst {
+				return syst, true
+			}
+		}
+	}
+	return systematic{}, false
+}
+
+// Helper function binding the weight factors of the systematic
+// named name, defined for the sample and the component, for
+// the up and the down variations, reading the branches renamed
+// by branches.
+func bindSystWeights(r *rtree.Reader, samp *Sample, comp *sampleComponent, name string, branches map[string]string) (up, down []func() float64, err error) {
+	for _, syst := range append(append([]systematic{}, samp.systs...), comp.systs...) {
+		if syst.Name != name || syst.Kind != weightSyst {
+			continue
+		}
+		fUp, err := syst.Up.renamed(branches).funcF64(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%q: %w", name, err)
+		}
+		fDown, err := syst.Down.renamed(branches).funcF64(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%q: %w", name, err)
+		}
+		up, down = append(up, fUp), append(down, fDown)
+	}
+	return up, down, nil
+}
+
+// Helper function creating the varied histograms of
+// the systematics defined for the sample.
+func (ana *Maker) newSystHistos(samp *Sample) []systHistos {
+	hs := make([]systHistos, len(ana.systNames))
+	for k, name := range ana.systNames {
+		if samp.hasSystematic(name) {
+			hs[k] = systHistos{up: ana.newHistos(), down: ana.newHistos()}
+		}
+	}
+	return hs
+}
+
+// Helper function adding the varied histograms of src to dst.
+func addSystHistos(dst, src []systHistos) {
+	for k := range dst {
+		if dst[k].up == nil {
+			continue
+		}
+		for ic := range dst[k].up {
+			for iv := range dst[k].up[ic] {
+				dst[k].up[ic][iv] = hbook.AddH1D(dst[k].up[ic][iv], src[k].up[ic][iv])
+				dst[k].down[ic][iv] = hbook.AddH1D(dst[k].down[ic][iv], src[k].down[ic][iv])
+			}
+		}
+	}
+}
+
+// Helper function returning, for each sample and systematic,
+// the up and down varied histograms of a given cut and variable,
+// normalized and binned as the nominal ones. The nominal
+// histogram is used if the sample has no such variation.
+func (ana *Maker) getSystHbookHistos(iCut, iVar int, nominal []*hbook.H1D) [][][2]*hbook.H1D {
+	hs := make([][][2]*hbook.H1D, len(ana.Samples))
+	for i := range ana.Samples {
+		hs[i] = make([][2]*hbook.H1D, len(ana.systNames))
+		for k, syst := range ana.hbookSysts[i] {
+			if syst.up == nil {
+				hs[i][k] = [2]*hbook.H1D{nominal[i], nominal[i]}
+				continue
+			}
+			hs[i][k] = [2]*hbook.H1D{
+				ana.normHbookHisto(syst.up[iCut][iVar], iCut, iVar, i),
+				ana.normHbookHisto(syst.down[iCut][iVar], iCut, iVar, i),
+			}
+		}
+	}
+	return hs
+}
+
+// Helper function returning the content of the sum of the
+// samples of indices idx, with the statistical and systematic
+// uncertainties combined in quadrature. For each systematic,
+// the largest upward (downward) shift of the up and down
+// variations is taken as the upper (lower) uncertainty.
+func systCounts(nominal []*hbook.H1D, systs [][][2]*hbook.H1D, idx []int) []hbook.Count {
+
+	// Nominal and varied sums
+	hTot := histTot(hbookHistoFromIdx(nominal, idx))
+	ups := make([]*hbook.H1D, len(systs[0]))
+	downs := make([]*hbook.H1D, len(systs[0]))
+	for k := range ups {
+		hs := make([][]*hbook.H1D, 2)
+		for _, i := range idx {
+			hs[0] = append(hs[0], systs[i][k][0])
+			hs[1] = append(hs[1], systs[i][k][1])
+		}
+		ups[k], downs[k] = histTot(hs[0]), histTot(hs[1])
+	}
+
+	// Combined uncertainties in each bin
+	counts := make([]hbook.Count, len(hTot.Binning.Bins))
+	for ib, b := range hTot.Binning.Bins {
+		val := b.SumW()
+		low2, high2 := b.SumW2(), b.SumW2()
+		for k := range ups {
+			du := ups[k].Binning.Bins[ib].SumW() - val
+			dd := downs[k].Binning.Bins[ib].SumW() - val
+			high := math.Max(math.Max(du, dd), 0)
+			low := math.Max(math.Max(-du, -dd), 0)
+			high2 += high * high
+			low2 += low * low
+		}
+		counts[ib].XRange = b.Range
+		counts[ib].Val = val
+		counts[ib].Err.Low = math.Sqrt(low2)
+		counts[ib].Err.High = math.Sqrt(high2)
+	}
+
+	return counts
+}
+
+// Helper function returning the relative uncertainties of
+// the counts, as points around one.
+func relativeCounts(counts []hbook.Count) *hbook.S2D {
+	pts := make([]hbook.Point2D, 0, len(counts))
+	for _, c := range counts {
+		if c.Val == 0 {
+			continue
+		}
+		dx := 0.5 * (c.XRange.Max - c.XRange.Min)
+		pts = append(pts, hbook.Point2D{
+			X:    c.XRange.Min + dx,
+			Y:    1,
+			ErrX: hbook.Range{Min: dx, Max: dx},
+			ErrY: hbook.Range{Min: c.Err.Low / math.Abs(c.Val), Max: c.Err.High / math.Abs(c.Val)},
+		})
+	}
+	return hbook.NewS2D(pts...)
+}
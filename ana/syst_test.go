@@ -0,0 +1,129 @@
+package ana_test
+
+import (
+	"os"
+	"testing"
+
+	"go-hep.org/x/hep/groot"
+	"go-hep.org/x/hep/groot/rhist"
+	"go-hep.org/x/hep/groot/riofs"
+	"go-hep.org/x/hep/groot/rtree"
+	"go-hep.org/x/hep/hbook/rootcnv"
+
+	"github.com/rmadar/tree-gonalyzer/ana"
+)
+
+// Helper function writing a tree of n events with the values
+// x=(i+0.5)*scale and, if sf is true, the weight factors of a
+// systematic variation sfUp=1.1 and sfDown=0.9.
+func writeSystTree(dir riofs.Directory, name string, n int, scale float32, sf bool) error {
+	var (
+		x            float32
+		sfUp, sfDown float64 = 1.1, 0.9
+		wvars                = []rtree.WriteVar{{Name: "x", Value: &x}}
+	)
+	if sf {
+		wvars = append(wvars,
+			rtree.WriteVar{Name: "sfUp", Value: &sfUp},
+			rtree.WriteVar{Name: "sfDown", Value: &sfDown},
+		)
+	}
+	w, err := rtree.NewWriter(dir, name, wvars)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		x = (float32(i) + 0.5) * scale
+		if _, err := w.Write(); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+// The alternative trees of a tree systematic, which don't have
+// the branches of the weight systematic of the sample, fill the
+// varied histograms.
+func TestTreeSystematics(t *testing.T) {
+	const path = "testdata/Histos_withTreeSystematics"
+	os.RemoveAll(path)
+	defer os.RemoveAll(path)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nominal tree, with the weight factors, and
+	// alternative trees with scaled x values.
+	fname := path + "/trees.root"
+	f, err := groot.Create(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tr := range []struct {
+		name  string
+		scale float32
+		sf    bool
+	}{
+		{"nominal", 1, true},
+		{"JES_up", 1.1, false},
+		{"JES_down", 0.9, false},
+	} {
+		if err := writeSystTree(f, tr.name, 100, tr.scale, tr.sf); err != nil {
+			t.Fatalf("could not write tree %s: %+v", tr.name, err)
+		}
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	samples := []*ana.Sample{
+		ana.CreateSample("bkg", "bkg", `Bkg`, fname, "nominal",
+			ana.WithSystematic("sf", ana.TreeVarF64("sfUp"), ana.TreeVarF64("sfDown")),
+			ana.WithTreeSystematic("JES", "JES_up", "JES_down"),
+		),
+	}
+	variables := []*ana.Variable{
+		ana.NewVariable("x", ana.TreeVarF32("x"), 2, 0, 100),
+	}
+	analyzer, err := ana.New(samples, variables,
+		ana.WithSavePath(path),
+		ana.WithSaveHistos("histos.root"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := analyzer.RunEventLoops(); err != nil {
+		t.Fatalf("could not run the event loops: %+v", err)
+	}
+
+	// Sum of weights in each bin [0, 50) and [50, 100),
+	// with the overflow.
+	hf, err := groot.Open(path + "/histos.root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hf.Close()
+	for _, tc := range []struct {
+		name string
+		want [3]float64
+	}{
+		{"bkg__x", [3]float64{50, 50, 0}},
+		{"bkg__x__sfUp", [3]float64{55, 55, 0}},
+		{"bkg__x__sfDown", [3]float64{45, 45, 0}},
+		{"bkg__x__JESUp", [3]float64{45, 46, 9}},
+		{"bkg__x__JESDown", [3]float64{56, 44, 0}},
+	} {
+		obj, err := riofs.Dir(hf).Get("all/" + tc.name)
+		if err != nil {
+			t.Fatalf("could not get %s: %+v", tc.name, err)
+		}
+		h := rootcnv.H1D(obj.(rhist.H1))
+		bins := h.Binning.Bins
+		got := [3]float64{bins[0].SumW(), bins[1].SumW(), h.Binning.Outflows[1].SumW()}
+		for i := range got {
+			if diff := got[i] - tc.want[i]; diff > 1e-9 || diff < -1e-9 {
+				t.Fatalf("%s: invalid bin contents: got=%v, want=%v", tc.name, got, tc.want)
+			}
+		}
+	}
+}
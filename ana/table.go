@@ -0,0 +1,54 @@
+package ana
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// tableWriter writes a table in all formats.
+type tableWriter interface {
+	WriteASCII(w io.Writer) error
+	WriteMarkdown(w io.Writer) error
+	WriteLaTeX(w io.Writer) error
+	WriteCSV(w io.Writer) error
+}
+
+// Helper function saving a table in SavePath, in all
+// formats, as name.txt, name.md, name.tex and name.csv.
+func (ana *Maker) saveTable(op, name string, t tableWriter) error {
+
+	if err := os.MkdirAll(ana.SavePath, 0755); err != nil {
+		return &Error{Op: op, File: ana.SavePath, Err: err}
+	}
+
+	for _, out := range []struct {
+		ext   string
+		write func(io.Writer) error
+	}{
+		{".txt", t.WriteASCII},
+		{".md", t.WriteMarkdown},
+		{".tex", t.WriteLaTeX},
+		{".csv", t.WriteCSV},
+	} {
+		fname := filepath.Join(ana.SavePath, name+out.ext)
+		if err := writeFile(fname, out.write); err != nil {
+			return &Error{Op: op, File: fname, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// Helper function creating a file written by write.
+func writeFile(fname string, write func(io.Writer) error) error {
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -1,7 +1,9 @@
 package ana
 
 import (
-	"log"
+	"fmt"
+	"os"
+	"sync"
 
 	"go-hep.org/x/hep/groot"
 	"go-hep.org/x/hep/groot/rtree"
@@ -13,6 +15,27 @@ type dumper struct {
 	VarsN []int32     // Storing the number of object in the F64s to dump the TTree.
 }
 
+// treeOut is the dumped tree of a sample, shared by all
+// the event loops of this sample.
+type treeOut struct {
+	mu sync.Mutex   // Protecting concurrent writes.
+	f  *groot.File  // Output file.
+	t  rtree.Writer // Output tree.
+	d  dumper       // Values bound to the output tree.
+}
+
+// write copies the values of d into the bound
+// values and writes them in the tree.
+func (o *treeOut) write(d dumper) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	copy(o.d.Var, d.Var)
+	copy(o.d.Vars, d.Vars)
+	copy(o.d.VarsN, d.VarsN)
+	_, err := o.t.Write()
+	return err
+}
+
 func (ana *Maker) newDumper() dumper {
 	dVar := make([]float64, len(ana.Variables)+len(ana.KinemCuts))
 	dVars := make([][]float64, len(ana.Variables))
@@ -39,18 +62,32 @@ func (ana *Maker) newDumper() dumper {
 
 // Helper function to assess variables type, needed
 // to instantiate a dumper before reading a tree.
-func (ana *Maker) assessVariableTypes() {
+func (ana *Maker) assessVariableTypes() error {
+
+	// Nothing to assess without samples or variables.
+	if len(ana.Samples) == 0 || len(ana.Samples[0].components) == 0 {
+		return nil
+	}
+	if len(ana.Variables)+len(ana.Variables2D)+len(ana.Profiles) == 0 {
+		return nil
+	}
 
 	// Get the main tree
-	fName := ana.Samples[0].components[0].FileName
-	tName := ana.Samples[0].components[0].TreeName
-	f, tMain := getTreeFromFile(fName, tName)
+	samp := ana.Samples[0]
+	comp := samp.components[0]
+	f, tMain, err := getTreeFromFile(comp.FileName, comp.TreeName)
+	if err != nil {
+		return &Error{Op: "get tree", Sample: samp.Name, File: comp.FileName, Tree: comp.TreeName, Err: err}
+	}
 	defer f.Close()
 
 	// Get associated trees
 	trees := []rtree.Tree{tMain}
-	for _, in := range ana.Samples[0].components[0].JointTrees {
-		fJoin, tJoin := getTreeFromFile(in.FileName, in.TreeName)
+	for _, in := range comp.JointTrees {
+		fJoin, tJoin, err := getTreeFromFile(in.FileName, in.TreeName)
+		if err != nil {
+			return &Error{Op: "get joint tree", Sample: samp.Name, File: in.FileName, Tree: in.TreeName, Err: err}
+		}
 		trees = append(trees, tJoin)
 		defer fJoin.Close()
 	}
@@ -58,38 +95,131 @@ func (ana *Maker) assessVariableTypes() {
 	// Join them
 	t, err := rtree.Join(trees...)
 	if err != nil {
-		log.Fatalf("could not join trees: %+v", err)
+		return &Error{Op: "join trees", Sample: samp.Name, File: comp.FileName, Tree: comp.TreeName, Err: err}
 	}
 
 	// Get reader associated to the final tree
 	r, err := rtree.NewReader(t, rtree.NewReadVars(t))
 	if err != nil {
-		log.Fatal("could not create tree reader: %w", err)
+		return &Error{Op: "create tree reader", Sample: samp.Name, File: comp.FileName, Tree: comp.TreeName, Err: err}
 	}
 	defer r.Close()
 
+	// Helper to add the variable context to an error.
+	varErr := func(name string, err error) error {
+		return &Error{
+			Op:       "assess variable type",
+			Sample:   samp.Name,
+			File:     comp.FileName,
+			Tree:     comp.TreeName,
+			Variable: name,
+			Err:      err,
+		}
+	}
+
 	// Loop over variable to assess whether they are float64
 	// or a slice of float64.
 	for _, v := range ana.Variables {
-		v.isSlice = false
-		if _, ok := v.TreeFunc.GetFuncF64(r); !ok {
-			v.isSlice = true
-			if _, ok = v.TreeFunc.GetFuncF64s(r); !ok {
-				err := "Type assertion failed [variable \"%v\"]:"
-				err += " TreeFunc.Fct must return a float64 or a []float64."
-				log.Fatalf(err, v.Name)
-			}
+		if v.isSlice, err = isSliceFunc(v.TreeFunc, r); err != nil {
+			return varErr(v.Name, err)
+		}
+	}
+
+	// Same for two-dimensional variables and profiles,
+	// whose x and y must be of the same kind.
+	isSliceXY := func(name string, fx, fy TreeFunc) (bool, error) {
+		isSliceX, err := isSliceFunc(fx, r)
+		if err != nil {
+			return false, varErr(name, err)
+		}
+		isSliceY, err := isSliceFunc(fy, r)
+		if err != nil {
+			return false, varErr(name, err)
+		}
+		if isSliceX != isSliceY {
+			err := "%w: x and y TreeFunc.Fct must both return a float64 or a []float64"
+			return false, varErr(name, fmt.Errorf(err, ErrFuncType))
 		}
+		return isSliceX, nil
 	}
+	for _, v := range ana.Variables2D {
+		if v.isSlice, err = isSliceXY(v.Name, v.XTreeFunc, v.YTreeFunc); err != nil {
+			return err
+		}
+	}
+	for _, p := range ana.Profiles {
+		if p.isSlice, err = isSliceXY(p.Name, p.XTreeFunc, p.YTreeFunc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Helper function returning true if the TreeFunc returns
+// a []float64, false if it returns a float64.
+func isSliceFunc(f TreeFunc, r *rtree.Reader) (bool, error) {
+	tf, err := f.formulaFrom(r)
+	if err != nil {
+		return false, err
+	}
+	switch fct := tf.Func().(type) {
+	case func() float64:
+		return false, nil
+	case func() []float64:
+		return true, nil
+	default:
+		err := "%w: TreeFunc.Fct must return a float64 or a []float64 (got %T)"
+		return false, fmt.Errorf(err, ErrFuncType, fct)
+	}
+}
+
+// Helper function creating the dumped trees of all samples.
+func (ana *Maker) newTreeOuts() ([]*treeOut, error) {
+
+	// Output directory
+	path := ana.SavePath + "/ntuples/"
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		os.MkdirAll(path, 0755)
+	}
+
+	outs := make([]*treeOut, len(ana.Samples))
+	for i, samp := range ana.Samples {
+		out := &treeOut{d: ana.newDumper()}
+		f, t, err := ana.getOutFileTree(path+samp.Name+".root", "GOtree", out.d)
+		if err != nil {
+			closeTreeOuts(ana.Samples, outs)
+			return nil, &Error{Op: "create dumped tree", Sample: samp.Name, Err: err}
+		}
+		out.f, out.t = f, t
+		outs[i] = out
+	}
+
+	return outs, nil
+}
+
+// Helper function closing the dumped trees of all samples,
+// and returning the first error.
+func closeTreeOuts(samples []*Sample, outs []*treeOut) error {
+	var err error
+	for i, out := range outs {
+		if out == nil {
+			continue
+		}
+		if errClose := closeOutFileTree(out.f, out.t); errClose != nil && err == nil {
+			err = &Error{Op: "close dumped tree", Sample: samples[i].Name, Err: errClose}
+		}
+	}
+	return err
 }
 
 // Helper function creating a file and tree to be dumped.
-func (ana *Maker) getOutFileTree(fname, tname string, d dumper) (*groot.File, rtree.Writer) {
+func (ana *Maker) getOutFileTree(fname, tname string, d dumper) (*groot.File, rtree.Writer, error) {
 
 	// Create a new ROOT file
 	f, err := groot.Create(fname)
 	if err != nil {
-		log.Fatalf("could not create ROOT file %v: %v", fname, err)
+		return nil, nil, fmt.Errorf("could not create ROOT file %v: %w", fname, err)
 	}
 
 	// Variables to save
@@ -122,8 +252,23 @@ func (ana *Maker) getOutFileTree(fname, tname string, d dumper) (*groot.File, rt
 	// Create a new TTree
 	t, err := rtree.NewWriter(f, tname, wvars)
 	if err != nil {
-		log.Fatal("could not create tree writer: %w", err)
+		f.Close()
+		return nil, nil, fmt.Errorf("could not create tree writer: %w", err)
 	}
 
-	return f, t
+	return f, t, nil
+}
+
+// Helper function closing a dumped tree and its file,
+// in this order, and returning the first error.
+func closeOutFileTree(f *groot.File, t rtree.Writer) error {
+	errTree := t.Close()
+	errFile := f.Close()
+	if errTree != nil {
+		return fmt.Errorf("could not close tree: %w", errTree)
+	}
+	if errFile != nil {
+		return fmt.Errorf("could not close root file: %w", errFile)
+	}
+	return nil
 }
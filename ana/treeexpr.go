@@ -0,0 +1,390 @@
+package ana
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"strconv"
+
+	"go-hep.org/x/hep/groot/rtree/rfunc"
+)
+
+// TreeExpr returns a TreeFunc computing the expression expr,
+// written with the Go syntax, from the branches it refers to, eg:
+//
+//	TreeExpr("t_pt > 50 && abs(l_eta) < 2.5")
+//	TreeExpr("sqrt(px*px + py*py)")
+//
+// The expression supports the numbers, true and false, the branch
+// names, the operators + - * / % (on numbers, % being the remainder
+// of math.Mod, with the sign of x in x % y), == != < <= > >=,
+// && || ! (on booleans), the parentheses and the math functions abs,
+// sqrt, exp, log, log10, pow, sin, cos, tan, asin, acos, atan, atan2,
+// sinh, cosh, tanh, hypot, floor, ceil, round, min and max. Scalar
+// branches of boolean, integer and floating point types are supported,
+// numbers being computed as float64. The type of each branch is read
+// from the tree when the function is bound to it, and the expression
+// is compiled into Go closures, without reflection in the event loop.
+// The function returns a float64, to be used as variable or weight,
+// or a bool, to be used as cut. An invalid expression is reported
+// when the function is bound to a tree.
+func TreeExpr(expr string) TreeFunc {
+	f, err := newTreeExpr(expr)
+	if err != nil {
+		return TreeFunc{
+			VarsName: []string{},
+			typed:    &treeExpr{src: expr, err: err},
+			id:       "TreeExpr(" + strconv.Quote(expr) + ")",
+		}
+	}
+	return f
+}
+
+// treeExpr is a parsed expression, referring
+// to the branches by their names.
+type treeExpr struct {
+	src   string   // Expression source.
+	node  ast.Expr // Parsed expression.
+	names []string // Branch names, in order of appearance.
+	err   error    // Parsing error, if any.
+}
+
+// Helper function parsing an expression into a TreeFunc.
+func newTreeExpr(expr string) (TreeFunc, error) {
+	node, err := parser.ParseExpr(expr)
+	if err != nil {
+		return TreeFunc{}, fmt.Errorf("%w: %q: %v", ErrExpr, expr, err)
+	}
+	names, err := exprBranches(node)
+	if err != nil {
+		return TreeFunc{}, fmt.Errorf("%w: %q: %v", ErrExpr, expr, err)
+	}
+	return TreeFunc{
+		VarsName: names,
+		typed:    &treeExpr{src: expr, node: node, names: names},
+		id:       "TreeExpr(" + strconv.Quote(expr) + ")",
+	}, nil
+}
+
+// Helper function returning the branch names of an expression, in
+// order of appearance, checking that only supported syntax is used.
+func exprBranches(node ast.Expr) ([]string, error) {
+	names := []string{}
+	seen := make(map[string]bool)
+	var walk func(n ast.Expr) error
+	walk = func(n ast.Expr) error {
+		switch n := n.(type) {
+		case *ast.BasicLit:
+			if n.Kind != token.INT && n.Kind != token.FLOAT {
+				return fmt.Errorf("unsupported literal %s", n.Value)
+			}
+			return nil
+		case *ast.Ident:
+			if n.Name != "true" && n.Name != "false" && !seen[n.Name] {
+				seen[n.Name] = true
+				names = append(names, n.Name)
+			}
+			return nil
+		case *ast.ParenExpr:
+			return walk(n.X)
+		case *ast.UnaryExpr:
+			return walk(n.X)
+		case *ast.BinaryExpr:
+			if err := walk(n.X); err != nil {
+				return err
+			}
+			return walk(n.Y)
+		case *ast.CallExpr:
+			fct, ok := n.Fun.(*ast.Ident)
+			if !ok {
+				return fmt.Errorf("unsupported call")
+			}
+			if _, ok := exprFuncs[fct.Name]; !ok {
+				return fmt.Errorf("unknown function %q", fct.Name)
+			}
+			for _, arg := range n.Args {
+				if err := walk(arg); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return fmt.Errorf("unsupported syntax %T", n)
+	}
+	err := walk(node)
+	return names, err
+}
+
+// Helper function returning the rfunc.Formula of the expression,
+// reading the branches named rvars. They replace the branch names
+// of the expression, in the same order.
+func (e *treeExpr) formula(rvars []string) (rfunc.Formula, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return &exprFormula{expr: e, rvars: rvars}, nil
+}
+
+// exprFormula is the rfunc.Formula of an expression,
+// compiled when bound to the branches.
+type exprFormula struct {
+	expr  *treeExpr
+	rvars []string
+	fct   interface{}
+}
+
+func (f *exprFormula) RVars() []string { return f.rvars }
+
+func (f *exprFormula) Bind(args []interface{}) error {
+	if got, want := len(args), len(f.rvars); got != want {
+		return fmt.Errorf(
+			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
+			got, want,
+		)
+	}
+	c := exprCompiler{ptrs: make(map[string]interface{}, len(args))}
+	for i, name := range f.expr.names {
+		c.ptrs[name] = args[i]
+	}
+	n, err := c.compile(f.expr.node)
+	if err != nil {
+		return fmt.Errorf("%w: %q: %v", ErrExpr, f.expr.src, err)
+	}
+	if n.num != nil {
+		f.fct = n.num
+	} else {
+		f.fct = n.bool
+	}
+	return nil
+}
+
+func (f *exprFormula) Func() interface{} { return f.fct }
+
+// exprNode is a compiled expression: either
+// a number or a boolean.
+type exprNode struct {
+	num  func() float64
+	bool func() bool
+}
+
+// exprCompiler compiles expressions, with the
+// pointers to the branch values bound by name.
+type exprCompiler struct {
+	ptrs map[string]interface{}
+}
+
+// Helper function compiling an expression into a closure.
+func (c *exprCompiler) compile(n ast.Expr) (exprNode, error) {
+	switch n := n.(type) {
+	case *ast.ParenExpr:
+		return c.compile(n.X)
+	case *ast.BasicLit:
+		return c.literal(n)
+	case *ast.Ident:
+		return c.branch(n.Name)
+	case *ast.UnaryExpr:
+		return c.unary(n)
+	case *ast.BinaryExpr:
+		return c.binary(n)
+	case *ast.CallExpr:
+		return c.call(n)
+	}
+	return exprNode{}, fmt.Errorf("unsupported syntax %T", n)
+}
+
+// Helper function compiling a number.
+func (c *exprCompiler) literal(n *ast.BasicLit) (exprNode, error) {
+	if n.Kind != token.INT && n.Kind != token.FLOAT {
+		return exprNode{}, fmt.Errorf("unsupported literal %s", n.Value)
+	}
+	v, err := strconv.ParseFloat(n.Value, 64)
+	if err != nil {
+		return exprNode{}, fmt.Errorf("invalid number %s", n.Value)
+	}
+	return exprNode{num: func() float64 { return v }}, nil
+}
+
+// Helper function compiling a boolean constant or a branch value.
+func (c *exprCompiler) branch(name string) (exprNode, error) {
+	switch name {
+	case "true":
+		return exprNode{bool: func() bool { return true }}, nil
+	case "false":
+		return exprNode{bool: func() bool { return false }}, nil
+	}
+	switch p := c.ptrs[name].(type) {
+	case *bool:
+		return exprNode{bool: func() bool { return *p }}, nil
+	case *float64:
+		return exprNode{num: func() float64 { return *p }}, nil
+	case *float32:
+		return exprNode{num: func() float64 { return float64(*p) }}, nil
+	case *int64:
+		return exprNode{num: func() float64 { return float64(*p) }}, nil
+	case *int32:
+		return exprNode{num: func() float64 { return float64(*p) }}, nil
+	case *int16:
+		return exprNode{num: func() float64 { return float64(*p) }}, nil
+	case *int8:
+		return exprNode{num: func() float64 { return float64(*p) }}, nil
+	case *uint64:
+		return exprNode{num: func() float64 { return float64(*p) }}, nil
+	case *uint32:
+		return exprNode{num: func() float64 { return float64(*p) }}, nil
+	case *uint16:
+		return exprNode{num: func() float64 { return float64(*p) }}, nil
+	case *uint8:
+		return exprNode{num: func() float64 { return float64(*p) }}, nil
+	case nil:
+		return exprNode{}, fmt.Errorf("unknown branch %q", name)
+	default:
+		return exprNode{}, fmt.Errorf("branch %q of type %T is not supported", name, p)
+	}
+}
+
+// Helper function compiling a unary operation.
+func (c *exprCompiler) unary(n *ast.UnaryExpr) (exprNode, error) {
+	x, err := c.compile(n.X)
+	if err != nil {
+		return exprNode{}, err
+	}
+	switch {
+	case n.Op == token.SUB && x.num != nil:
+		fx := x.num
+		return exprNode{num: func() float64 { return -fx() }}, nil
+	case n.Op == token.ADD && x.num != nil:
+		return x, nil
+	case n.Op == token.NOT && x.bool != nil:
+		fx := x.bool
+		return exprNode{bool: func() bool { return !fx() }}, nil
+	}
+	return exprNode{}, fmt.Errorf("invalid operation %s on %s", n.Op, x.kind())
+}
+
+// Helper function compiling a binary operation.
+func (c *exprCompiler) binary(n *ast.BinaryExpr) (exprNode, error) {
+	x, err := c.compile(n.X)
+	if err != nil {
+		return exprNode{}, err
+	}
+	y, err := c.compile(n.Y)
+	if err != nil {
+		return exprNode{}, err
+	}
+
+	// Boolean operations
+	if x.bool != nil && y.bool != nil {
+		fx, fy := x.bool, y.bool
+		switch n.Op {
+		case token.LAND:
+			return exprNode{bool: func() bool { return fx() && fy() }}, nil
+		case token.LOR:
+			return exprNode{bool: func() bool { return fx() || fy() }}, nil
+		case token.EQL:
+			return exprNode{bool: func() bool { return fx() == fy() }}, nil
+		case token.NEQ:
+			return exprNode{bool: func() bool { return fx() != fy() }}, nil
+		}
+	}
+
+	// Numerical operations and comparisons
+	if x.num != nil && y.num != nil {
+		fx, fy := x.num, y.num
+		switch n.Op {
+		case token.ADD:
+			return exprNode{num: func() float64 { return fx() + fy() }}, nil
+		case token.SUB:
+			return exprNode{num: func() float64 { return fx() - fy() }}, nil
+		case token.MUL:
+			return exprNode{num: func() float64 { return fx() * fy() }}, nil
+		case token.QUO:
+			return exprNode{num: func() float64 { return fx() / fy() }}, nil
+		case token.REM:
+			return exprNode{num: func() float64 { return math.Mod(fx(), fy()) }}, nil
+		case token.EQL:
+			return exprNode{bool: func() bool { return fx() == fy() }}, nil
+		case token.NEQ:
+			return exprNode{bool: func() bool { return fx() != fy() }}, nil
+		case token.LSS:
+			return exprNode{bool: func() bool { return fx() < fy() }}, nil
+		case token.LEQ:
+			return exprNode{bool: func() bool { return fx() <= fy() }}, nil
+		case token.GTR:
+			return exprNode{bool: func() bool { return fx() > fy() }}, nil
+		case token.GEQ:
+			return exprNode{bool: func() bool { return fx() >= fy() }}, nil
+		}
+	}
+
+	return exprNode{}, fmt.Errorf("invalid operation %s between %s and %s", n.Op, x.kind(), y.kind())
+}
+
+// Helper function compiling a call to a math function.
+func (c *exprCompiler) call(n *ast.CallExpr) (exprNode, error) {
+	name := n.Fun.(*ast.Ident).Name
+	fct := exprFuncs[name]
+
+	args := make([]func() float64, len(n.Args))
+	for i, arg := range n.Args {
+		a, err := c.compile(arg)
+		if err != nil {
+			return exprNode{}, err
+		}
+		if a.num == nil {
+			return exprNode{}, fmt.Errorf("invalid %s argument to %s", a.kind(), name)
+		}
+		args[i] = a.num
+	}
+
+	switch f := fct.(type) {
+	case func(float64) float64:
+		if len(args) != 1 {
+			return exprNode{}, fmt.Errorf("%s takes 1 argument (got %d)", name, len(args))
+		}
+		x := args[0]
+		return exprNode{num: func() float64 { return f(x()) }}, nil
+	case func(float64, float64) float64:
+		if len(args) != 2 {
+			return exprNode{}, fmt.Errorf("%s takes 2 arguments (got %d)", name, len(args))
+		}
+		x, y := args[0], args[1]
+		return exprNode{num: func() float64 { return f(x(), y()) }}, nil
+	}
+	return exprNode{}, fmt.Errorf("unknown function %q", name)
+}
+
+// Helper function returning the kind of a compiled expression.
+func (n exprNode) kind() string {
+	if n.bool != nil {
+		return "bool"
+	}
+	return "number"
+}
+
+// Math functions available in expressions.
+var exprFuncs = map[string]interface{}{
+	"abs":   math.Abs,
+	"sqrt":  math.Sqrt,
+	"exp":   math.Exp,
+	"log":   math.Log,
+	"log10": math.Log10,
+	"sin":   math.Sin,
+	"cos":   math.Cos,
+	"tan":   math.Tan,
+	"asin":  math.Asin,
+	"acos":  math.Acos,
+	"atan":  math.Atan,
+	"sinh":  math.Sinh,
+	"cosh":  math.Cosh,
+	"tanh":  math.Tanh,
+	"floor": math.Floor,
+	"ceil":  math.Ceil,
+	"round": math.Round,
+	"pow":   math.Pow,
+	"atan2": math.Atan2,
+	"hypot": math.Hypot,
+	"min":   math.Min,
+	"max":   math.Max,
+}
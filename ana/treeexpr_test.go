@@ -0,0 +1,101 @@
+package ana
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestTreeExpr(t *testing.T) {
+	vars := map[string]interface{}{
+		"x": func() *float64 { v := 7.5; return &v }(),
+		"f": func() *float32 { v := float32(-2); return &v }(),
+		"n": func() *int32 { v := int32(-7); return &v }(),
+		"u": func() *uint8 { v := uint8(3); return &v }(),
+		"b": func() *bool { v := true; return &v }(),
+		"s": new(string),
+	}
+
+	// Helper function binding an expression to the
+	// pointers of its branches, nil if unknown.
+	bind := func(expr string) (interface{}, error) {
+		f := TreeExpr(expr)
+		ff, err := f.formula()
+		if err != nil {
+			return nil, err
+		}
+		ptrs := make([]interface{}, len(f.VarsName))
+		for i, name := range f.VarsName {
+			ptrs[i] = vars[name]
+		}
+		if err := ff.Bind(ptrs); err != nil {
+			return nil, err
+		}
+		return ff.Func(), nil
+	}
+
+	for _, tc := range []struct {
+		expr string
+		want interface{}
+	}{
+		{expr: "x", want: 7.5},
+		{expr: "2*x + f", want: 13.0},
+		{expr: "-n + u", want: 10.0},
+		{expr: "(x - 1.5) / 2", want: 3.0},
+		{expr: "x % 2", want: 1.5},
+		{expr: "n % 3", want: -1.0},
+		{expr: "7 % -3", want: 1.0},
+		{expr: "pow(u, 2)", want: 9.0},
+		{expr: "atan2(0, -1)", want: math.Pi},
+		{expr: "max(abs(n), sqrt(u*3))", want: 7.0},
+		{expr: "b", want: true},
+		{expr: "!b || x < 0", want: false},
+		{expr: "b && f >= -2 && n != u", want: true},
+		{expr: "(x > 1) == b", want: true},
+		{expr: "true != false", want: true},
+	} {
+		fct, err := bind(tc.expr)
+		if err != nil {
+			t.Fatalf("%q: could not bind: %+v", tc.expr, err)
+		}
+		var got interface{}
+		switch f := fct.(type) {
+		case func() float64:
+			got = f()
+		case func() bool:
+			got = f()
+		}
+		if got != tc.want {
+			t.Fatalf("%q: got=%v (%T), want=%v (%T)", tc.expr, got, got, tc.want, tc.want)
+		}
+	}
+
+	// Errors
+	for _, expr := range []string{
+		"x >",
+		"foo(x)",
+		"math.Sqrt(x)",
+		"nope + 1",
+		"s == 1",
+		"b + 1",
+		"x + b",
+		"!x",
+		"-b",
+		"b < true",
+		"pow(x)",
+		"atan2(x, n, u)",
+		"sqrt(x, 2)",
+		"abs(b)",
+		`"a"`,
+		`x == "a"`,
+		"'a'",
+		"x.y",
+		"x[0]",
+		"x ? 1 : 0",
+		"func() {}",
+	} {
+		if _, err := bind(expr); !errors.Is(err, ErrExpr) {
+			t.Fatalf("%q: expected ErrExpr, got %v", expr, err)
+		}
+	}
+}
@@ -1,8 +1,8 @@
 package ana
 
 import (
+	"fmt"
 	"log"
-	"reflect"
 
 	"go-hep.org/x/hep/groot/rtree"
 	"go-hep.org/x/hep/groot/rtree/rfunc"
@@ -25,15 +25,29 @@ type TreeFunc struct {
 	VarsName []string      // List of branch names, being function arguments
 	Fct      interface{}   // User-defined function
 	Formula  rfunc.Formula // Formula that can be bound to a ROOT tree
+	id       string        // Identity of the function, if not its source code.
+	typed    typedFormula  // Formula typed when bound to a tree, if any.
 }
 
-// IsSlow returns false if the f.Fct is already
-// defined in rtree/rfunc package or in TreeFunc.
+// typedFormula creates the rfunc.Formula of a TreeFunc reading
+// the branches rvars, typed once bound to the tree.
+type typedFormula interface {
+	formula(rvars []string) (rfunc.Formula, error)
+}
+
+// IsSlow returns false if the type of f.Fct has a fast formula,
+// either generated, ie if it takes up to two arguments of type
+// bool, int32, int64, float32, float64 or their slices, or three
+// or four arguments of the same such type, and returns a bool, a
+// float64 or a []float64, or registered with RegisterFormula.
+// Any combination of up to four arguments has a fast formula if
+// package ana/allfuncs is imported.
 // If true, a generic rfunc function is used (based on refect),
 // which is roughly 5 times slower.
+// TreeFunc's without Fct are never slow.
 func (f *TreeFunc) IsSlow() bool {
 	if f.Fct != nil {
-		_, ok := funcs[reflect.TypeOf(f.Fct)]
+		_, ok := formulaMaker(f.Fct)
 		return !ok
 	} else {
 		return false
@@ -109,6 +123,7 @@ func TreeValF64(v float64) TreeFunc {
 	return TreeFunc{
 		VarsName: []string{},
 		Fct:      func() float64 { return v },
+		id:       fmt.Sprintf("TreeValF64(%v)", v),
 	}
 }
 
@@ -172,26 +187,16 @@ func TreeVarI32s(v string) TreeFunc {
 // a user-defined rfunc, it's loaded. A generic rtree function
 // is loaded otherwise (~5 times slower).
 func (f *TreeFunc) FuncFormula() rfunc.Formula {
-	if f.Formula != nil {
-		return f.Formula
-	} else {
-		var ff rfunc.Formula
-		var err error
-		if mk, ok := funcs[reflect.TypeOf(f.Fct)]; ok {
-			ff, err = mk(f.VarsName, f.Fct)
-		} else {
-			ff, err = rfunc.NewGenericFormula(f.VarsName, f.Fct)
-		}
-		if err != nil {
-			log.Fatalf("could not create formula func: %+v", err)
-		}
-		return ff
+	ff, err := f.formula()
+	if err != nil {
+		log.Fatalf("could not create formula func: %+v", err)
 	}
+	return ff
 }
 
 // TreeFormulaFrom returns a rfunc.Formula bound to the reader r.
 func (f *TreeFunc) TreeFormulaFrom(r *rtree.Reader) rfunc.Formula {
-	tf, err := r.Formula(f.FuncFormula())
+	tf, err := f.formulaFrom(r)
 	if err != nil {
 		log.Fatalf("could not create formulaFunc: %+v", err)
 	}
@@ -218,3 +223,96 @@ func (f *TreeFunc) GetFuncBool(r *rtree.Reader) (func() bool, bool) {
 	fct, ok := f.TreeFormulaFrom(r).Func().(func() bool)
 	return fct, ok
 }
+
+// Helper function returning true if the function of f is defined.
+func (f *TreeFunc) isSet() bool {
+	return f.Fct != nil || f.Formula != nil || f.typed != nil
+}
+
+// Helper function returning a copy of f, with the branch
+// names substituted according to m. TreeFunc defined with
+// a Formula are returned unchanged.
+func (f *TreeFunc) renamed(m map[string]string) *TreeFunc {
+	if len(m) == 0 || f.Formula != nil {
+		return f
+	}
+	g := *f
+	g.VarsName = make([]string, len(f.VarsName))
+	for i, n := range f.VarsName {
+		if alt, ok := m[n]; ok {
+			n = alt
+		}
+		g.VarsName[i] = n
+	}
+	return &g
+}
+
+// Helper function returning the rfunc.Formula associated
+// to f, or the error preventing its creation.
+func (f *TreeFunc) formula() (rfunc.Formula, error) {
+	if f.Formula != nil {
+		return f.Formula, nil
+	}
+	if f.typed != nil {
+		return f.typed.formula(f.VarsName)
+	}
+	if mk, ok := formulaMaker(f.Fct); ok {
+		return mk(f.VarsName, f.Fct)
+	}
+	return rfunc.NewGenericFormula(f.VarsName, f.Fct)
+}
+
+// Helper function returning the rfunc.Formula bound to the
+// reader r, or the error preventing its binding.
+func (f *TreeFunc) formulaFrom(r *rtree.Reader) (rfunc.Formula, error) {
+	ff, err := f.formula()
+	if err != nil {
+		return nil, fmt.Errorf("could not create formula func: %w", err)
+	}
+	tf, err := r.Formula(ff)
+	if err != nil {
+		return nil, fmt.Errorf("could not bind formula func: %w", err)
+	}
+	return tf, nil
+}
+
+// Helper function returning the float64 function bound to r.
+func (f *TreeFunc) funcF64(r *rtree.Reader) (func() float64, error) {
+	tf, err := f.formulaFrom(r)
+	if err != nil {
+		return nil, err
+	}
+	fct, ok := tf.Func().(func() float64)
+	if !ok {
+		return nil, fmt.Errorf("%w: TreeFunc.Fct must return a float64 (got %T)", ErrFuncType, tf.Func())
+	}
+	return fct, nil
+}
+
+// Helper function returning the []float64 function bound to r.
+func (f *TreeFunc) funcF64s(r *rtree.Reader) (func() []float64, error) {
+	tf, err := f.formulaFrom(r)
+	if err != nil {
+		return nil, err
+	}
+	fct, ok := tf.Func().(func() []float64)
+	if !ok {
+		return nil, fmt.Errorf("%w: TreeFunc.Fct must return a []float64 (got %T)", ErrFuncType, tf.Func())
+	}
+	return fct, nil
+}
+
+// Helper function returning the boolean function bound to r.
+func (f *TreeFunc) funcBool(r *rtree.Reader) (func() bool, error) {
+	tf, err := f.formulaFrom(r)
+	if err != nil {
+		return nil, err
+	}
+	fct, ok := tf.Func().(func() bool)
+	if !ok {
+		err := "%w: TreeFunc.Fct must return a bool (got %T),"
+		err += " make sure to use TreeCutBool(), not TreeVarBool()"
+		return nil, fmt.Errorf(err, ErrFuncType, tf.Func())
+	}
+	return fct, nil
+}
@@ -0,0 +1,224 @@
+package ana
+
+import (
+	"fmt"
+
+	"go-hep.org/x/hep/groot/rtree/rfunc"
+)
+
+// TreeAt returns a TreeFunc to get the element i of a slice
+// (or fixed-size array) branch, eg the leading (i=0) or the
+// sub-leading (i=1) object, as a float64. The value def is
+// returned if the slice has not enough elements.
+func TreeAt(v string, i int, def float64) TreeFunc {
+	return TreeFunc{
+		VarsName: []string{v},
+		typed: sliceFormula(func(s []sliceReader) func() float64 {
+			x := s[0]
+			return func() float64 {
+				if i < 0 || i >= x.len() {
+					return def
+				}
+				return x.at(i)
+			}
+		}),
+		id: fmt.Sprintf("TreeAt(%d, %v)", i, def),
+	}
+}
+
+// TreeLen returns a TreeFunc to get the length of a slice
+// branch, eg the multiplicity of objects, as a float64.
+func TreeLen(v string) TreeFunc {
+	return TreeFunc{
+		VarsName: []string{v},
+		typed: sliceFormula(func(s []sliceReader) func() float64 {
+			x := s[0]
+			return func() float64 { return float64(x.len()) }
+		}),
+		id: "TreeLen",
+	}
+}
+
+// TreeSum returns a TreeFunc to get the sum of the elements
+// of a slice branch, as a float64.
+func TreeSum(v string) TreeFunc {
+	return TreeFunc{
+		VarsName: []string{v},
+		typed: sliceFormula(func(s []sliceReader) func() float64 {
+			x := s[0]
+			return func() float64 {
+				sum := 0.0
+				for i, n := 0, x.len(); i < n; i++ {
+					sum += x.at(i)
+				}
+				return sum
+			}
+		}),
+		id: "TreeSum",
+	}
+}
+
+// TreeMin returns a TreeFunc to get the minimum of the elements
+// of a slice branch, as a float64. The value def is returned for
+// empty slices.
+func TreeMin(v string, def float64) TreeFunc {
+	return TreeFunc{
+		VarsName: []string{v},
+		typed: sliceFormula(func(s []sliceReader) func() float64 {
+			return sliceExtremum(s[0], def, func(a, b float64) bool { return a < b })
+		}),
+		id: fmt.Sprintf("TreeMin(%v)", def),
+	}
+}
+
+// TreeMax returns a TreeFunc to get the maximum of the elements
+// of a slice branch, as a float64. The value def is returned for
+// empty slices.
+func TreeMax(v string, def float64) TreeFunc {
+	return TreeFunc{
+		VarsName: []string{v},
+		typed: sliceFormula(func(s []sliceReader) func() float64 {
+			return sliceExtremum(s[0], def, func(a, b float64) bool { return a > b })
+		}),
+		id: fmt.Sprintf("TreeMax(%v)", def),
+	}
+}
+
+// TreeAtSortedBy returns a TreeFunc to get the element of the
+// slice branch v of the object having the i-th largest value of
+// the slice branch by, eg the pseudo-rapidity of the leading
+// (i=0) or the sub-leading (i=1) object in pT. Both slices are
+// read up to the length of the shortest one, and the value def
+// is returned if they have not enough elements. Objects with the
+// same value of by keep their order in the slices.
+func TreeAtSortedBy(v, by string, i int, def float64) TreeFunc {
+	return TreeFunc{
+		VarsName: []string{v, by},
+		typed: sliceFormula(func(s []sliceReader) func() float64 {
+			x, key := s[0], s[1]
+			idx := make([]int, 0, i+1)
+			return func() float64 {
+				n := x.len()
+				if nk := key.len(); nk < n {
+					n = nk
+				}
+				if i < 0 || i >= n {
+					return def
+				}
+				// Keep the i+1 largest keys, sorted by
+				// insertion into the re-used index buffer.
+				idx = idx[:0]
+				for j := 0; j < n; j++ {
+					kj := key.at(j)
+					k := len(idx)
+					for k > 0 && key.at(idx[k-1]) < kj {
+						k--
+					}
+					if k > i {
+						continue
+					}
+					if len(idx) <= i {
+						idx = append(idx, 0)
+					}
+					copy(idx[k+1:], idx[k:])
+					idx[k] = j
+				}
+				return x.at(idx[i])
+			}
+		}),
+		id: fmt.Sprintf("TreeAtSortedBy(%d, %v)", i, def),
+	}
+}
+
+// Helper function returning the function computing the extremum
+// of the slice x, according to better, or def if it is empty.
+func sliceExtremum(x sliceReader, def float64, better func(a, b float64) bool) func() float64 {
+	return func() float64 {
+		n := x.len()
+		if n == 0 {
+			return def
+		}
+		res := x.at(0)
+		for i := 1; i < n; i++ {
+			if v := x.at(i); better(v, res) {
+				res = v
+			}
+		}
+		return res
+	}
+}
+
+// sliceReader gives access to the elements of a bound slice
+// branch as float64, without copying it.
+type sliceReader struct {
+	len func() int
+	at  func(i int) float64
+}
+
+// Helper function returning the reader of the slice pointed
+// by ptr, if its type is supported.
+func newSliceReader(ptr interface{}) (sliceReader, bool) {
+	switch p := arrayAsSlice(ptr).(type) {
+	case *[]float64:
+		return sliceReader{
+			len: func() int { return len(*p) },
+			at:  func(i int) float64 { return (*p)[i] },
+		}, true
+	case *[]float32:
+		return sliceReader{
+			len: func() int { return len(*p) },
+			at:  func(i int) float64 { return float64((*p)[i]) },
+		}, true
+	case *[]int64:
+		return sliceReader{
+			len: func() int { return len(*p) },
+			at:  func(i int) float64 { return float64((*p)[i]) },
+		}, true
+	case *[]int32:
+		return sliceReader{
+			len: func() int { return len(*p) },
+			at:  func(i int) float64 { return float64((*p)[i]) },
+		}, true
+	}
+	return sliceReader{}, false
+}
+
+// sliceFormula creates the formula of a float64 computed
+// from slice branches, the function being built from their
+// readers when the formula is bound. It is the fast formula
+// of the slice helpers, for any type of slice branches.
+type sliceFormula func(s []sliceReader) func() float64
+
+func (sf sliceFormula) formula(rvars []string) (rfunc.Formula, error) {
+	return &sliceFormulaFunc{rvars: rvars, mk: sf}, nil
+}
+
+// sliceFormulaFunc is the rfunc.Formula of a sliceFormula.
+type sliceFormulaFunc struct {
+	rvars []string
+	mk    sliceFormula
+	fct   func() float64
+}
+
+func (f *sliceFormulaFunc) RVars() []string { return f.rvars }
+
+func (f *sliceFormulaFunc) Bind(args []interface{}) error {
+	if got, want := len(args), len(f.rvars); got != want {
+		return fmt.Errorf(
+			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
+			got, want,
+		)
+	}
+	s := make([]sliceReader, len(args))
+	for i, arg := range args {
+		r, ok := newSliceReader(arg)
+		if !ok {
+			return fmt.Errorf("%w: branch %q of type %T is not a supported slice", ErrFuncType, f.rvars[i], arg)
+		}
+		s[i] = r
+	}
+	f.fct = f.mk(s)
+	return nil
+}
+
+func (f *sliceFormulaFunc) Func() interface{} { return f.fct }
@@ -0,0 +1,62 @@
+package ana
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTreeSliceHelpers(t *testing.T) {
+	pt := &[]float32{20, 50, 35, 50}
+	eta := &[]int64{1, 2, 3, 4}
+	for _, tc := range []struct {
+		name string
+		f    TreeFunc
+		args []interface{}
+		want float64
+	}{
+		{name: "at", f: TreeAt("x", 1, -1), args: []interface{}{pt}, want: 50},
+		{name: "at-default", f: TreeAt("x", 4, -1), args: []interface{}{pt}, want: -1},
+		{name: "len", f: TreeLen("x"), args: []interface{}{pt}, want: 4},
+		{name: "len-empty", f: TreeLen("x"), args: []interface{}{&[]int32{}}, want: 0},
+		{name: "sum", f: TreeSum("x"), args: []interface{}{&[]int32{1, 2, 3}}, want: 6},
+		{name: "min", f: TreeMin("x", -1), args: []interface{}{pt}, want: 20},
+		{name: "min-empty", f: TreeMin("x", -1), args: []interface{}{&[]float64{}}, want: -1},
+		{name: "max", f: TreeMax("x", -1), args: []interface{}{&[2]int64{3, 7}}, want: 7},
+		{name: "sorted-0", f: TreeAtSortedBy("x", "y", 0, -1), args: []interface{}{eta, pt}, want: 2},
+		{name: "sorted-1", f: TreeAtSortedBy("x", "y", 1, -1), args: []interface{}{eta, pt}, want: 4},
+		{name: "sorted-2", f: TreeAtSortedBy("x", "y", 2, -1), args: []interface{}{eta, pt}, want: 3},
+		{name: "sorted-3", f: TreeAtSortedBy("x", "y", 3, -1), args: []interface{}{eta, pt}, want: 1},
+		{name: "sorted-short", f: TreeAtSortedBy("x", "y", 2, -1), args: []interface{}{&[]int64{1, 2}, pt}, want: -1},
+	} {
+		ff, err := tc.f.formula()
+		if err != nil {
+			t.Fatalf("%s: could not create formula: %+v", tc.name, err)
+		}
+		if err := ff.Bind(tc.args); err != nil {
+			t.Fatalf("%s: could not bind formula: %+v", tc.name, err)
+		}
+		fct := ff.Func().(func() float64)
+		if got := fct(); got != tc.want {
+			t.Fatalf("%s: got=%v, want=%v", tc.name, got, tc.want)
+		}
+		// A second call gives the same result.
+		if got := fct(); got != tc.want {
+			t.Fatalf("%s: second call got=%v, want=%v", tc.name, got, tc.want)
+		}
+	}
+
+	// Unsupported types
+	for _, ptr := range []interface{}{new(float32), &[]bool{}, &[]string{}} {
+		f := TreeLen("x")
+		ff, _ := f.formula()
+		if err := ff.Bind([]interface{}{ptr}); !errors.Is(err, ErrFuncType) {
+			t.Fatalf("%T: expected ErrFuncType, got %v", ptr, err)
+		}
+	}
+
+	// The helpers are fast.
+	f := TreeAtSortedBy("x", "y", 0, -1)
+	if f.IsSlow() {
+		t.Fatalf("slice helpers should not be slow")
+	}
+}
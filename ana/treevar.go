@@ -0,0 +1,209 @@
+package ana
+
+import (
+	"fmt"
+	"reflect"
+
+	"go-hep.org/x/hep/groot/rtree/rfunc"
+)
+
+// TreeVar returns a TreeFunc to get a single branch-based
+// variable to plot, whatever its type. The type of the branch
+// is read from the tree when the function is bound to it: scalars
+// (boolean, integer and floating point) lead to a float64, while
+// variable-length slices and fixed-size arrays lead to a []float64.
+func TreeVar(v string) TreeFunc {
+	return TreeFunc{
+		VarsName: []string{v},
+		typed:    branchFormula{},
+		id:       "TreeVar",
+	}
+}
+
+// TreeCut returns a TreeFunc to get a single branch-based
+// variable for cuts, whatever its type. The type of the branch
+// is read from the tree when the function is bound to it: boolean
+// branches are used as they are, while numerical branches pass
+// the cut when they are not zero.
+func TreeCut(v string) TreeFunc {
+	return TreeFunc{
+		VarsName: []string{v},
+		typed:    branchFormula{cut: true},
+		id:       "TreeCut",
+	}
+}
+
+// branchFormula creates the formula reading a single
+// branch, for variables or cuts.
+type branchFormula struct {
+	cut bool
+}
+
+func (b branchFormula) formula(rvars []string) (rfunc.Formula, error) {
+	if len(rvars) != 1 {
+		return nil, fmt.Errorf("rfunc: num-branches/func-arity mismatch")
+	}
+	return &branchFormulaFunc{rvars: rvars, cut: b.cut}, nil
+}
+
+// branchFormulaFunc is the rfunc.Formula of a single branch,
+// converted to the output type when bound.
+type branchFormulaFunc struct {
+	rvars []string
+	cut   bool
+	fct   interface{}
+}
+
+func (f *branchFormulaFunc) RVars() []string { return f.rvars }
+
+func (f *branchFormulaFunc) Bind(args []interface{}) error {
+	if got, want := len(args), 1; got != want {
+		return fmt.Errorf(
+			"rfunc: invalid number of bind arguments (got=%d, want=%d)",
+			got, want,
+		)
+	}
+	name, ptr := f.rvars[0], arrayAsSlice(args[0])
+
+	// Slices
+	if fct, ok := sliceToF64s(ptr); ok {
+		if f.cut {
+			return fmt.Errorf("%w: branch %q of type %T cannot be a cut", ErrFuncType, name, args[0])
+		}
+		f.fct = fct
+		return nil
+	}
+
+	// Scalars
+	c := exprCompiler{ptrs: map[string]interface{}{name: ptr}}
+	n, err := c.branch(name)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrFuncType, err)
+	}
+	switch {
+	case f.cut && n.bool != nil:
+		f.fct = n.bool
+	case f.cut:
+		x := n.num
+		f.fct = func() bool { return x() != 0 }
+	case n.bool != nil:
+		x := n.bool
+		f.fct = func() float64 {
+			if x() {
+				return 1
+			}
+			return 0
+		}
+	default:
+		f.fct = n.num
+	}
+	return nil
+}
+
+func (f *branchFormulaFunc) Func() interface{} { return f.fct }
+
+// Helper function returning a pointer to a slice sharing the
+// memory of the fixed-size array pointed by ptr, or ptr if it
+// doesn't point to an array.
+func arrayAsSlice(ptr interface{}) interface{} {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Array {
+		return ptr
+	}
+	s := v.Elem().Slice(0, v.Elem().Len())
+	ps := reflect.New(s.Type())
+	ps.Elem().Set(s)
+	return ps.Interface()
+}
+
+// Helper function returning the function converting
+// the slice pointed by ptr to a []float64, if supported.
+func sliceToF64s(ptr interface{}) (func() []float64, bool) {
+	switch p := ptr.(type) {
+	case *[]float64:
+		return func() []float64 { return *p }, true
+	case *[]float32:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				res[i] = float64(x)
+			}
+			return res
+		}, true
+	case *[]int64:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				res[i] = float64(x)
+			}
+			return res
+		}, true
+	case *[]int32:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				res[i] = float64(x)
+			}
+			return res
+		}, true
+	case *[]int16:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				res[i] = float64(x)
+			}
+			return res
+		}, true
+	case *[]int8:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				res[i] = float64(x)
+			}
+			return res
+		}, true
+	case *[]uint64:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				res[i] = float64(x)
+			}
+			return res
+		}, true
+	case *[]uint32:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				res[i] = float64(x)
+			}
+			return res
+		}, true
+	case *[]uint16:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				res[i] = float64(x)
+			}
+			return res
+		}, true
+	case *[]uint8:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				res[i] = float64(x)
+			}
+			return res
+		}, true
+	case *[]bool:
+		return func() []float64 {
+			res := make([]float64, len(*p))
+			for i, x := range *p {
+				if x {
+					res[i] = 1
+				}
+			}
+			return res
+		}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,82 @@
+package ana
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTreeVarBind(t *testing.T) {
+	for _, tc := range []struct {
+		ptr  interface{}
+		cut  bool
+		want interface{}
+	}{
+		{ptr: &[]int32{1, 2}, want: []float64{1, 2}},
+		{ptr: &[]uint8{3}, want: []float64{3}},
+		{ptr: &[]bool{true, false}, want: []float64{1, 0}},
+		{ptr: &[]float32{}, want: []float64{}},
+		{ptr: &[2]int64{4, 5}, want: []float64{4, 5}},
+		{ptr: &[2]float32{0.5, 1.5}, want: []float64{0.5, 1.5}},
+		{ptr: new(uint16), want: 0.0},
+		{ptr: new(bool), cut: true, want: false},
+		{ptr: new(int32), cut: true, want: false},
+	} {
+		f := TreeVar("x")
+		if tc.cut {
+			f = TreeCut("x")
+		}
+		ff, err := f.formula()
+		if err != nil {
+			t.Fatalf("%T: could not create formula: %+v", tc.ptr, err)
+		}
+		if err := ff.Bind([]interface{}{tc.ptr}); err != nil {
+			t.Fatalf("%T: could not bind formula: %+v", tc.ptr, err)
+		}
+		got := reflect.ValueOf(ff.Func()).Call(nil)[0].Interface()
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("%T: got=%v, want=%v", tc.ptr, got, tc.want)
+		}
+	}
+
+	// Fixed-size arrays are followed when read.
+	arr := &[2]int32{1, 2}
+	fv, fc := TreeVar("x"), TreeCut("x")
+	ff, _ := fv.formula()
+	if err := ff.Bind([]interface{}{arr}); err != nil {
+		t.Fatalf("could not bind formula: %+v", err)
+	}
+	arr[1] = 3
+	if got, want := ff.Func().(func() []float64)(), []float64{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("array: got=%v, want=%v", got, want)
+	}
+
+	// Non-zero numbers pass the cuts.
+	n := new(float32)
+	ff, _ = fc.formula()
+	if err := ff.Bind([]interface{}{n}); err != nil {
+		t.Fatalf("could not bind formula: %+v", err)
+	}
+	*n = 2
+	if !ff.Func().(func() bool)() {
+		t.Fatalf("non-zero value should pass the cut")
+	}
+
+	// Errors
+	for _, tc := range []struct {
+		ptr interface{}
+		cut bool
+	}{
+		{ptr: new(string)},
+		{ptr: &[]float64{}, cut: true},
+	} {
+		f := TreeVar("x")
+		if tc.cut {
+			f = TreeCut("x")
+		}
+		ff, _ := f.formula()
+		if err := ff.Bind([]interface{}{tc.ptr}); !errors.Is(err, ErrFuncType) {
+			t.Fatalf("%T: expected ErrFuncType, got %v", tc.ptr, err)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package ana
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"sync"
+
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+
+	"go-hep.org/x/hep/hbook"
+	"go-hep.org/x/hep/hplot"
+	"go-hep.org/x/hep/hplot/htex"
+
+	"github.com/rmadar/hplot-style/style"
+)
+
+func (ana *Maker) concurrentPlotVar2D(iVar, iCut int, latex htex.Handler, err *error, wg *sync.WaitGroup) {
+
+	// Handle concurrency
+	defer wg.Done()
+
+	// Plot the 2D histos
+	if e := ana.plotVar2D(iVar, iCut, latex); e != nil {
+		*err = &Error{
+			Op:        "plot 2D variable",
+			Variable:  ana.Variables2D[iVar].Name,
+			Selection: ana.KinemCuts[iCut].Name,
+			Err:       e,
+		}
+	}
+}
+
+// plotVar2D saves one heat map per sample and, if there are
+// data and background samples, the map of the data over the
+// total background ratio. Plots are named '<SaveName>_<Sample>'
+// and '<SaveName>_ratio'.
+func (ana *Maker) plotVar2D(iVar, iCut int, latex htex.Handler) error {
+
+	// Current variable
+	v := ana.Variables2D[iVar]
+
+	// Output directory
+	path := ana.SavePath + "/" + ana.KinemCuts[iCut].Name
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return fmt.Errorf("could not create output directory: %w", err)
+		}
+	}
+
+	// Histograms of this variable and selection
+	hs := make([]*hbook.H2D, len(ana.Samples))
+	for i := range ana.Samples {
+		hs[i] = ana.hbookHistos2D[i][iCut][iVar]
+	}
+
+	// One heat map per sample
+	for i, s := range ana.Samples {
+		grid := newH2DGrid(hs[i:i+1], ana.HistoNorm)
+		cmap := moreland.ExtendedBlackBody()
+		zlabel := "Events"
+		if ana.HistoNorm {
+			zlabel = "PDF"
+		}
+		drw := ana.newHeatMapFigure(v, s.LegLabel, grid, cmap, zlabel, 0, 0)
+		outputname := path + "/" + v.SaveName + "_" + s.Name + "." + ana.SaveFormat
+		if err := ana.saveHeatMapFigure(drw, latex, outputname); err != nil {
+			return err
+		}
+	}
+
+	// Ratio map between data and the total background
+	if len(ana.idxData) == 0 || len(ana.idxBkgs) == 0 {
+		return nil
+	}
+	grid := ratioGrid{
+		num: newH2DGrid(hbookHisto2DFromIdx(hs, ana.idxData), ana.HistoNorm),
+		den: newH2DGrid(hbookHisto2DFromIdx(hs, ana.idxBkgs), ana.HistoNorm),
+	}
+	zmin, zmax := v.RatioZmin, v.RatioZmax
+	if zmin == zmax {
+		zmin, zmax = ratioRange(grid)
+	}
+	cmap := moreland.SmoothBlueRed()
+	drw := ana.newHeatMapFigure(v, "Data / MC", grid, cmap, "Ratio", zmin, zmax)
+	outputname := path + "/" + v.SaveName + "_ratio." + ana.SaveFormat
+	return ana.saveHeatMapFigure(drw, latex, outputname)
+}
+
+// Helper function creating a heat map figure for a 2D variable.
+// If zmin==zmax, the color scale covers the data range.
+func (ana *Maker) newHeatMapFigure(v *Variable2D, title string, grid plotter.GridXYZ,
+	cmap palette.ColorMap, zlabel string, zmin, zmax float64) heatMapFigure {
+
+	// Heat map, with a color scale shared with the color bar
+	hm := plotter.NewHeatMap(grid, cmap.Palette(255))
+	if zmin != zmax {
+		hm.Min, hm.Max = zmin, zmax
+	}
+	switch {
+	case math.IsInf(hm.Min, 0) || math.IsInf(hm.Max, 0):
+		hm.Min, hm.Max = 0, 1
+	case hm.Min >= hm.Max:
+		hm.Max = hm.Min + 1
+	}
+	cmap.SetMin(hm.Min)
+	cmap.SetMax(hm.Max)
+
+	// Saturate the colors out of the color scale range
+	colors := hm.Palette.Colors()
+	hm.Underflow, hm.Overflow = colors[0], colors[len(colors)-1]
+
+	// Main plot
+	plt := hplot.New()
+	plt.Add(hm)
+	plt.Title.Text = title
+	style.ApplyToPlot(plt)
+	v.setPlotStyle(plt)
+
+	// Color bar
+	bar := hplot.New()
+	bar.Add(&plotter.ColorBar{ColorMap: cmap, Vertical: true})
+	style.ApplyToPlot(bar)
+	bar.HideX()
+	bar.X.Padding, bar.Y.Padding = 0, 0
+	bar.Y.Tick.Marker = hplot.Ticks{N: 5}
+	bar.Y.Label.Text = zlabel
+
+	return heatMapFigure{plt: plt, bar: bar}
+}
+
+// Helper function saving a heat map figure.
+func (ana *Maker) saveHeatMapFigure(drw heatMapFigure, latex htex.Handler, fname string) error {
+	f := hplot.Figure(drw)
+	style.ApplyToFigure(f)
+	f.Latex = latex
+	if err := hplot.Save(f, 6.5*vg.Inch, 4.5*vg.Inch, fname); err != nil {
+		return fmt.Errorf("could not save plot: %w", err)
+	}
+	return nil
+}
+
+// heatMapFigure draws a plot and its color bar
+// on the right, aligned with the plot data area.
+type heatMapFigure struct {
+	plt *hplot.Plot
+	bar *hplot.Plot
+}
+
+// Draw implements the hplot.Drawer interface.
+func (f heatMapFigure) Draw(c draw.Canvas) {
+	w := c.Size().X
+	cPlt := draw.Crop(c, 0, -0.2*w, 0, 0)
+	f.plt.Draw(cPlt)
+	dc := f.plt.DataCanvas(cPlt)
+	cBar := draw.Crop(c, 0.81*w, -0.05*w, 0, 0)
+	cBar.Min.Y, cBar.Max.Y = dc.Min.Y, dc.Max.Y
+	f.bar.Draw(cBar)
+}
+
+// h2dGrid implements plotter.GridXYZ for the sum of 2D
+// histograms with the same binning. If normalized,
+// the sum is scaled to unit area.
+type h2dGrid struct {
+	hs    []*hbook.H2D
+	scale float64
+}
+
+func newH2DGrid(hs []*hbook.H2D, norm bool) h2dGrid {
+	g := h2dGrid{hs: hs, scale: 1}
+	if norm {
+		sumw := 0.0
+		for _, h := range hs {
+			sumw += h.Integral()
+		}
+		if sumw != 0 {
+			g.scale = 1 / sumw
+		}
+	}
+	return g
+}
+
+func (g h2dGrid) Dims() (c, r int) {
+	return g.hs[0].Binning.Nx, g.hs[0].Binning.Ny
+}
+
+func (g h2dGrid) Z(c, r int) float64 {
+	idx := r*g.hs[0].Binning.Nx + c
+	z := 0.0
+	for _, h := range g.hs {
+		z += h.Binning.Bins[idx].SumW()
+	}
+	return z * g.scale
+}
+
+func (g h2dGrid) X(c int) float64 {
+	return g.hs[0].Binning.Bins[c].XMid()
+}
+
+func (g h2dGrid) Y(r int) float64 {
+	return g.hs[0].Binning.Bins[r*g.hs[0].Binning.Nx].YMid()
+}
+
+// ratioGrid implements plotter.GridXYZ for the bin-by-bin
+// ratio of two grids. Empty bins of den give NaN.
+type ratioGrid struct {
+	num, den h2dGrid
+}
+
+func (g ratioGrid) Dims() (c, r int) { return g.num.Dims() }
+func (g ratioGrid) X(c int) float64  { return g.num.X(c) }
+func (g ratioGrid) Y(r int) float64  { return g.num.Y(r) }
+
+func (g ratioGrid) Z(c, r int) float64 {
+	den := g.den.Z(c, r)
+	if den == 0 {
+		return math.NaN()
+	}
+	return g.num.Z(c, r) / den
+}
+
+// Helper function returning a range centered on one,
+// including all the finite ratio values.
+func ratioRange(g ratioGrid) (float64, float64) {
+	d := 0.0
+	nc, nr := g.Dims()
+	for c := 0; c < nc; c++ {
+		for r := 0; r < nr; r++ {
+			if z := g.Z(c, r); !math.IsNaN(z) && !math.IsInf(z, 0) {
+				d = math.Max(d, math.Abs(z-1))
+			}
+		}
+	}
+	if d == 0 {
+		d = 1
+	}
+	return 1 - d, 1 + d
+}
+
+// Helper function returning a slice of hbook 2D histo
+// corresponding to a list of indices.
+func hbookHisto2DFromIdx(src []*hbook.H2D, indices []int) []*hbook.H2D {
+	dst := make([]*hbook.H2D, len(indices))
+	for i, idx := range indices {
+		dst[i] = src[idx]
+	}
+	return dst
+}
@@ -1,28 +1,38 @@
 package ana
 
 import (
+	"fmt"
+	"regexp"
+
 	"go-hep.org/x/hep/hplot"
 )
 
 type Variable struct {
-	Name                     string   // Variable name.
-	TreeFunc                 TreeFunc // Variable definition from branches & functions.
-	Nbins                    int      // Number of bins of final histograms.
-	Xmin, Xmax               float64  // Mininum and maximum values of the histogram.
-	LogY                     bool     // Enable logarithm scale for the y-axis.
-	SaveName                 string   // Name of the saved plot (default 'Name').
-	XLabel, YLabel           string   // Axis labels (default: 'Variable', 'Events').
-	XTickFormat, YTickFormat string   // Axis tick formatting (default: hplot default).
-	RangeXmin, RangeXmax     float64  // X-axis range (default: hplot default).
-	RangeYmin, RangeYmax     float64  // Y-axis range (default: hplot default).
-	RatioYmin, RatioYmax     float64  // Ratio Y-axis range (default: hplot default).
-	LegPosTop, LegPosLeft    bool     // Legend position (default: true, false)
-	isSlice bool
+	Name                     string    // Variable name.
+	TreeFunc                 TreeFunc  // Variable definition from branches & functions.
+	Nbins                    int       // Number of bins of final histograms.
+	Xmin, Xmax               float64   // Mininum and maximum values of the histogram.
+	BinEdges                 []float64 // Edges of variable-width bins (default: none).
+	LogY                     bool      // Enable logarithm scale for the y-axis.
+	SaveName                 string    // Name of the saved plot (default 'Name').
+	XLabel, YLabel           string    // Axis labels (default: 'Variable', 'Events').
+	XTickFormat, YTickFormat string    // Axis tick formatting (default: hplot default).
+	RangeXmin, RangeXmax     float64   // X-axis range (default: hplot default).
+	RangeYmin, RangeYmax     float64   // Y-axis range (default: hplot default).
+	RatioYmin, RatioYmax     float64   // Ratio Y-axis range (default: hplot default).
+	LegPosTop, LegPosLeft    bool      // Legend position (default: true, false)
+	FlowMode                 FlowMode  // Under/overflow handling (default: analysis setting).
+	isSlice                  bool
+	err                      error
 }
 
 // NewVariable creates a new variable value with
 // default settings. The TreeFunc object should returns either
 // a float64 or a []float64. Any other returned type will panic.
+// If bin edges are given with WithBinEdges, they override nBins,
+// xMin and xMax, the plotted histograms are divided by the bin
+// width and the y-axis label, if not given, becomes 'Events / <unit>',
+// where the unit is read between brackets in the x-axis label.
 func NewVariable(name string, tFunc TreeFunc, nBins int, xMin, xMax float64, opts ...VariableOptions) *Variable {
 
 	// Create the object
@@ -89,9 +99,51 @@ func NewVariable(name string, tFunc TreeFunc, nBins int, xMin, xMax float64, opt
 	if cfg.LegPosLeft.usr {
 		v.LegPosLeft = cfg.LegPosLeft.val
 	}
+	if cfg.VarFlowMode.usr {
+		v.FlowMode = cfg.VarFlowMode.val
+	}
+	if cfg.BinEdges.usr {
+		if err := checkBinEdges(cfg.BinEdges.val); err != nil {
+			v.err = &Error{Op: "create variable", Variable: name, Err: err}
+			return v
+		}
+		edges := cfg.BinEdges.val
+		v.BinEdges = append([]float64{}, edges...)
+		v.Nbins = len(edges) - 1
+		v.Xmin, v.Xmax = edges[0], edges[len(edges)-1]
+		if v.YLabel == "" || !cfg.YLabel.usr {
+			v.YLabel = "Events / " + unitFromLabel(v.XLabel)
+		}
+	}
 	return v
 }
 
+// Helper function checking that bin edges define
+// at least one bin and are strictly increasing.
+func checkBinEdges(edges []float64) error {
+	if len(edges) < 2 {
+		return fmt.Errorf("%w: at least two edges are needed", ErrBinEdges)
+	}
+	for i := 1; i < len(edges); i++ {
+		if edges[i] <= edges[i-1] {
+			return fmt.Errorf("%w: edges are not strictly increasing", ErrBinEdges)
+		}
+	}
+	return nil
+}
+
+var unitRegexp = regexp.MustCompile(`\[([^\[\]]+)\]\s*$`)
+
+// Helper function returning the unit of an axis label,
+// written between brackets at the end of the label, eg
+// '$M_{tt}$ [GeV]'. It returns 'Unit' if there is none.
+func unitFromLabel(label string) string {
+	if m := unitRegexp.FindStringSubmatch(label); m != nil {
+		return m[1]
+	}
+	return "Unit"
+}
+
 // SetPlotStyle sets the user-specified style on
 // the hplot.Plot value.
 func (v Variable) setPlotStyle(p *hplot.Plot) {
@@ -0,0 +1,129 @@
+package ana
+
+import (
+	"go-hep.org/x/hep/hplot"
+)
+
+// Variable2D contains the information needed to fill and plot
+// a two-dimensional histogram, for each sample and selection.
+// The two TreeFunc objects must either both return a float64,
+// or both return a []float64. In the latter case, the elements
+// of the two slices are paired by index. Two-dimensional
+// variables are not included in dumped trees.
+type Variable2D struct {
+	Name                     string   // Variable name.
+	XTreeFunc, YTreeFunc     TreeFunc // Variable definitions from branches & functions.
+	Nx, Ny                   int      // Number of bins along x and y.
+	Xmin, Xmax               float64  // Mininum and maximum values along x.
+	Ymin, Ymax               float64  // Mininum and maximum values along y.
+	SaveName                 string   // Base name of the saved plots (default 'Name').
+	XLabel, YLabel           string   // Axis labels (default: 'X variable', 'Y variable').
+	XTickFormat, YTickFormat string   // Axis tick formatting (default: hplot default).
+	RangeXmin, RangeXmax     float64  // X-axis range (default: hplot default).
+	RangeYmin, RangeYmax     float64  // Y-axis range (default: hplot default).
+	RatioZmin, RatioZmax     float64  // Color scale range of the ratio map (default: data range).
+	isSlice                  bool
+}
+
+// NewVariable2D creates a new two-dimensional variable with
+// default settings. xFunc and yFunc define the values along x
+// and y, and must both return either a float64 or a []float64.
+// The options WithSaveName, WithAxisLabels, WithTickFormats,
+// WithXRange, WithYRange and WithRatioZRange are taken into
+// account, others are ignored.
+func NewVariable2D(name string, xFunc, yFunc TreeFunc,
+	nx int, xmin, xmax float64,
+	ny int, ymin, ymax float64,
+	opts ...VariableOptions) *Variable2D {
+
+	// Create the object
+	v := &Variable2D{
+		Name:      name,
+		XTreeFunc: xFunc,
+		YTreeFunc: yFunc,
+		Nx:        nx,
+		Xmin:      xmin,
+		Xmax:      xmax,
+		Ny:        ny,
+		Ymin:      ymin,
+		Ymax:      ymax,
+		SaveName:  name,
+		XLabel:    `X variable`,
+		YLabel:    `Y variable`,
+	}
+
+	// Configuration with default values for all optional fields
+	cfg := newConfig()
+
+	// Update the configuration looping over functional options
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	// Set fields with updaded configuration
+	if cfg.SaveName.usr {
+		v.SaveName = cfg.SaveName.val
+	}
+	if cfg.XLabel.usr {
+		v.XLabel = cfg.XLabel.val
+	}
+	if cfg.YLabel.usr {
+		v.YLabel = cfg.YLabel.val
+	}
+	if cfg.XTickFormat.usr {
+		v.XTickFormat = cfg.XTickFormat.val
+	}
+	if cfg.YTickFormat.usr {
+		v.YTickFormat = cfg.YTickFormat.val
+	}
+	if cfg.RangeXmin.usr {
+		v.RangeXmin = cfg.RangeXmin.val
+	}
+	if cfg.RangeXmax.usr {
+		v.RangeXmax = cfg.RangeXmax.val
+	}
+	if cfg.RangeYmin.usr {
+		v.RangeYmin = cfg.RangeYmin.val
+	}
+	if cfg.RangeYmax.usr {
+		v.RangeYmax = cfg.RangeYmax.val
+	}
+	if cfg.RatioZmin.usr {
+		v.RatioZmin = cfg.RatioZmin.val
+	}
+	if cfg.RatioZmax.usr {
+		v.RatioZmax = cfg.RatioZmax.val
+	}
+	return v
+}
+
+// SetPlotStyle sets the user-specified style on
+// the hplot.Plot value.
+func (v Variable2D) setPlotStyle(p *hplot.Plot) {
+
+	// Plot labels
+	if v.XLabel != "" {
+		p.X.Label.Text = v.XLabel
+	}
+	if v.YLabel != "" {
+		p.Y.Label.Text = v.YLabel
+	}
+
+	// Axis ranges
+	if v.RangeXmin != v.RangeXmax {
+		p.X.Min = v.RangeXmin
+		p.X.Max = v.RangeXmax
+	}
+	if v.RangeYmin != v.RangeYmax {
+		p.Y.Min = v.RangeYmin
+		p.Y.Max = v.RangeYmax
+	}
+
+	// Axis ticks tuning
+	if v.XTickFormat != "" {
+		p.X.Tick.Marker = hplot.Ticks{N: 10, Format: v.XTickFormat}
+	}
+	if v.YTickFormat != "" {
+		p.Y.Tick.Marker = hplot.Ticks{N: 10, Format: v.YTickFormat}
+	}
+}
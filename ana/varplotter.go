@@ -1,8 +1,8 @@
 package ana
 
 import (
+	"fmt"
 	"image/color"
-	"log"
 	"os"
 	"sync"
 	"time"
@@ -20,6 +20,9 @@ import (
 
 // PlotVariables loops over all filled histograms and produce one plot
 // for each variable and selection, including all sample histograms.
+// For two-dimensional variables, one heat map is produced per sample,
+// together with the map of the data over background ratio. For
+// profiles, the mean values of all samples are overlaid.
 func (ana *Maker) PlotVariables() error {
 
 	if !ana.PlotHisto {
@@ -36,13 +39,12 @@ func (ana *Maker) PlotVariables() error {
 
 	// Return an error if hbookHistos is empty
 	if !ana.histoFilled {
-		err := "There is no histograms. Please make sure that"
-		err += "'FillHistos()' is called before 'PlotVariables()'"
-		log.Fatalf(err)
+		err := fmt.Errorf("%w: RunEventLoops() must be called before PlotVariables()", ErrNoHistos)
+		return &Error{Op: "plot variables", Err: err}
 	}
 
 	// Compute all normalizations beforehand
-	ana.normHists, ana.normTotal = ana.Normalizations()
+	ana.normHists, ana.normTotal = ana.normalizations()
 
 	// Handle on-the-fly LaTeX compilation
 	var latex htex.Handler = htex.NoopHandler{}
@@ -50,12 +52,25 @@ func (ana *Maker) PlotVariables() error {
 		latex = htex.NewGoHandler(-1, "pdflatex")
 	}
 
-	// Loop over variables and cuts
+	// Loop over variables and cuts, keeping track of errors
 	var wg sync.WaitGroup
-	wg.Add(len(ana.Variables) * len(ana.KinemCuts))
+	nCuts := len(ana.KinemCuts)
+	nVars, nVars2D, nProfs := len(ana.Variables), len(ana.Variables2D), len(ana.Profiles)
+	errs := make([]error, (nVars+nVars2D+nProfs)*nCuts)
+	wg.Add((nVars + nVars2D + nProfs) * nCuts)
 	for iv := range ana.Variables {
 		for ic := range ana.KinemCuts {
-			go ana.concurrentPlotVar(iv, ic, latex, &wg)
+			go ana.concurrentPlotVar(iv, ic, latex, &errs[iv*nCuts+ic], &wg)
+		}
+	}
+	for iv := range ana.Variables2D {
+		for ic := range ana.KinemCuts {
+			go ana.concurrentPlotVar2D(iv, ic, latex, &errs[(nVars+iv)*nCuts+ic], &wg)
+		}
+	}
+	for ip := range ana.Profiles {
+		for ic := range ana.KinemCuts {
+			go ana.concurrentPlotProfile(ip, ic, latex, &errs[(nVars+nVars2D+ip)*nCuts+ic], &wg)
 		}
 	}
 	wg.Wait()
@@ -63,26 +78,40 @@ func (ana *Maker) PlotVariables() error {
 	// Handle latex compilation
 	if latex, ok := latex.(*htex.GoHandler); ok {
 		if err := latex.Wait(); err != nil {
-			log.Fatalf("could not compiler latex document(s): %+v", err)
+			return &Error{Op: "compile latex", Err: err}
 		}
 	}
 
 	// End timing
 	ana.timePlot = time.Since(start)
 
+	// Report the first error, following variables and cuts order.
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (ana *Maker) concurrentPlotVar(iVar, iCut int, latex htex.Handler, wg *sync.WaitGroup) {
+func (ana *Maker) concurrentPlotVar(iVar, iCut int, latex htex.Handler, err *error, wg *sync.WaitGroup) {
 
 	// Handle concurrency
 	defer wg.Done()
 
-	// Fill the histo
-	ana.plotVar(iVar, iCut, latex)
+	// Plot the histo
+	if e := ana.plotVar(iVar, iCut, latex); e != nil {
+		*err = &Error{
+			Op:        "plot variable",
+			Variable:  ana.Variables[iVar].Name,
+			Selection: ana.KinemCuts[iCut].Name,
+			Err:       e,
+		}
+	}
 }
 
-func (ana *Maker) plotVar(iVar, iCut int, latex htex.Handler) {
+func (ana *Maker) plotVar(iVar, iCut int, latex htex.Handler) error {
 
 	// Current variable
 	v := ana.Variables[iVar]
@@ -105,6 +134,9 @@ func (ana *Maker) plotVar(iVar, iCut int, latex htex.Handler) {
 	phSigs := hplotHistoFromIdx(phistos, ana.idxSigs)
 	stack := ana.stackHistograms(phBkgs, phSigs, v.LogY)
 
+	// Combine statistical and systematic uncertainties in the bands
+	bkgCounts := ana.addSystToBands(iCut, iVar, bhistos, phistos, stack)
+
 	// Data
 	phData := hplotHistoFromIdx(phistos, ana.idxData)
 
@@ -122,6 +154,12 @@ func (ana *Maker) plotVar(iVar, iCut int, latex htex.Handler) {
 		plt.Legend.Add("Uncer.", hBand)
 	}
 
+	// Mark the under/overflow bins, below the histograms
+	showFlows := ana.flowMode(v) == FlowShow
+	if showFlows {
+		plt.Add(flowMarks(v)...)
+	}
+
 	// Add histogram and stacks to the plot
 	if stack != nil {
 		plt.Add(stack)
@@ -155,9 +193,14 @@ func (ana *Maker) plotVar(iVar, iCut int, latex htex.Handler) {
 		// Update the drawer and figure size
 		figWidth, figHeight = 6*vg.Inch, 4.5*vg.Inch
 		drw = rp
+		if showFlows {
+			rp.Bottom.Add(flowMarks(v)...)
+		}
 
 		// Compute and add ratios to the plot
-		ana.addRatioToPlot(rp, bhistos, phistos)
+		if err := ana.addRatioToPlot(rp, bhistos, phistos, bkgCounts); err != nil {
+			return err
+		}
 
 		// Adjust ratio plot scale
 		if v.RatioYmin != v.RatioYmax {
@@ -174,12 +217,16 @@ func (ana *Maker) plotVar(iVar, iCut int, latex htex.Handler) {
 	// Save the figure
 	path := ana.SavePath + "/" + ana.KinemCuts[iCut].Name
 	if _, err := os.Stat(path); os.IsNotExist(err) {
-		os.MkdirAll(path, 0755)
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return fmt.Errorf("could not create output directory: %w", err)
+		}
 	}
 	outputname := path + "/" + v.SaveName + "." + ana.SaveFormat
 	if err := hplot.Save(f, figWidth, figHeight, outputname); err != nil {
-		log.Fatalf("error saving plot: %v\n", err)
+		return fmt.Errorf("could not save plot: %w", err)
 	}
+
+	return nil
 }
 
 // Helper function to setup the automatic style.
@@ -224,44 +271,72 @@ func (ana *Maker) setAutoStyle() {
 	}
 }
 
-// Helper function computing the normalisation of
-// of all samples for a given cut
+// Normalizations returns the normalisation of all samples for
+// each cut, indexed as [iCut][iSample], and of their total for each
+// cut, as used to plot the first variable: under/overflows are
+// included only if they are plotted, according to its flow mode.
 func (ana *Maker) Normalizations() ([][]float64, []float64) {
+	norms, nTot := ana.normalizations()
+	n, tot := make([][]float64, len(ana.KinemCuts)), make([]float64, len(ana.KinemCuts))
+	if len(ana.Variables) == 0 {
+		return n, tot
+	}
+	for ic := range ana.KinemCuts {
+		n[ic], tot[ic] = norms[ic][0], nTot[ic][0]
+	}
+	return n, tot
+}
+
+// Helper function computing the normalisation of
+// all samples for each cut and variable, indexed
+// as [iCut][iVar][iSample] and [iCut][iVar] for the
+// total. Under/overflows are included only if they
+// are plotted, according to the flow mode.
+func (ana *Maker) normalizations() ([][][]float64, [][]float64) {
 
 	// Initialization
-	nTot := make([]float64, len(ana.KinemCuts))
-	norms := make([][]float64, len(ana.KinemCuts))
-	for i := range norms {
-		norms[i] = make([]float64, len(ana.Samples))
+	nTot := make([][]float64, len(ana.KinemCuts))
+	norms := make([][][]float64, len(ana.KinemCuts))
+	for ic := range norms {
+		nTot[ic] = make([]float64, len(ana.Variables))
+		norms[ic] = make([][]float64, len(ana.Variables))
+		for iv := range norms[ic] {
+			norms[ic][iv] = make([]float64, len(ana.Samples))
+		}
 	}
 
 	// If no normalization is needed, compute nothing.
 	if !ana.HistoNorm {
 		for ic := range ana.KinemCuts {
-			nTot[ic] = 1.0
-			for is := range ana.Samples {
-				norms[ic][is] = 1.0
+			for iv := range ana.Variables {
+				nTot[ic][iv] = 1.0
+				for is := range ana.Samples {
+					norms[ic][iv][is] = 1.0
+				}
 			}
 		}
 		return norms, nTot
 	}
 
-	// Otherwise, loop over cuts and samples.
-	for ic, _ := range ana.KinemCuts {
-		for is, s := range ana.Samples {
+	// Otherwise, loop over cuts, variables and samples.
+	for ic := range ana.KinemCuts {
+		for iv, v := range ana.Variables {
+			mode := ana.flowMode(v)
+			for is, s := range ana.Samples {
 
-			// Individual normalization including under/over-flows
-			n := ana.hbookHistos[is][ic][0].Integral()
-			norms[ic][is] = n
+				// Individual normalization over the plotted range
+				n := visibleIntegral(ana.hbookHistos[is][ic][iv], mode)
+				norms[ic][iv][is] = n
 
-			// Cumulate backgrounds for the total
-			if s.IsBkg() {
-				nTot[ic] += n
-			}
+				// Cumulate backgrounds for the total
+				if s.IsBkg() {
+					nTot[ic][iv] += n
+				}
 
-			// Cumulate signals for the total, it stacked
-			if s.IsSig() && ana.SignalStack {
-				nTot[ic] += n
+				// Cumulate signals for the total, it stacked
+				if s.IsSig() && ana.SignalStack {
+					nTot[ic][iv] += n
+				}
 			}
 		}
 	}
@@ -273,37 +348,62 @@ func (ana *Maker) Normalizations() ([][]float64, []float64) {
 // of a given cut  and variable, for bkgs, sigs and data.
 func (ana *Maker) getNormHbookHistos(iCut, iVar int) []*hbook.H1D {
 
-	// Get normalization
-	nHistos, nTot := ana.normHists[iCut], ana.normTotal[iCut]
-
 	// Prepare histo maps
 	bhistos := make([]*hbook.H1D, len(ana.Samples))
 
 	// Loop over sample
-	for i, s := range ana.Samples {
+	for i := range ana.Samples {
+		bhistos[i] = ana.normHbookHisto(ana.hbookHistos[i][iCut][iVar], iCut, iVar, i)
+	}
 
-		// Get a clone of the histo
-		h := ana.hbookHistos[i][iCut][iVar].Clone()
-
-		// Normalize
-		if ana.HistoNorm {
-			switch s.sType {
-			case data:
-				h.Scale(1 / nHistos[i])
-			case bkg, sig:
-				if ana.HistoStack {
-					h.Scale(1. / nTot)
-				} else {
-					h.Scale(1. / nHistos[i])
-				}
+	return bhistos
+}
+
+// Helper function returning a normalized copy of the histogram
+// of a given cut, variable and sample, with the under/overflows
+// handled according to the flow mode.
+func (ana *Maker) normHbookHisto(h *hbook.H1D, iCut, iVar, iSamp int) *hbook.H1D {
+
+	// Get normalization
+	nHistos, nTot := ana.normHists[iCut][iVar], ana.normTotal[iCut][iVar]
+	v := ana.Variables[iVar]
+
+	// Get a clone of the histo, with under/overflows
+	h = applyFlowMode(h.Clone(), ana.flowMode(v))
+
+	// Normalize
+	if ana.HistoNorm {
+		switch ana.Samples[iSamp].sType {
+		case data:
+			h.Scale(1 / nHistos[iSamp])
+		case bkg, sig:
+			if ana.HistoStack {
+				h.Scale(1. / nTot)
+			} else {
+				h.Scale(1. / nHistos[iSamp])
 			}
 		}
+	}
 
-		// Store
-		bhistos[i] = h
+	// Divide variable-width bins by their width
+	if len(v.BinEdges) > 0 {
+		divideByBinWidth(h)
 	}
 
-	return bhistos
+	return h
+}
+
+// Helper function dividing the content of each bin
+// of the histogram, and its uncertainty, by the bin width.
+func divideByBinWidth(h *hbook.H1D) {
+	for i := range h.Binning.Bins {
+		b := &h.Binning.Bins[i]
+		f := 1 / b.XWidth()
+		b.Dist.Dist.SumW *= f
+		b.Dist.Dist.SumW2 *= f * f
+		b.Dist.Stats.SumWX *= f
+		b.Dist.Stats.SumWX2 *= f
+	}
 }
 
 // Helper function to get hplot histograms from hbook histograms.
@@ -382,14 +482,52 @@ func (ana *Maker) stackHistograms(hBkgs, hSigs []*hplot.H1D, LogY bool) *hplot.H
 	return stack
 }
 
+// Helper function replacing the error bands of the samples having
+// systematic variations, and the total band of the stack, by the
+// statistical and systematic uncertainties combined in quadrature.
+// It returns the content of the total background with these
+// uncertainties, or nil if there is no systematic variation.
+func (ana *Maker) addSystToBands(iCut, iVar int, bhistos []*hbook.H1D, phistos []*hplot.H1D, stack *hplot.HStack) []hbook.Count {
+
+	if len(ana.systNames) == 0 {
+		return nil
+	}
+
+	// Varied histograms
+	bsysts := ana.getSystHbookHistos(iCut, iVar, bhistos)
+
+	// Individual sample bands
+	for i, s := range ana.Samples {
+		if s.hasSystematics() && phistos[i].Band != nil {
+			phistos[i].Band.Counts = systCounts(bhistos, bsysts, []int{i})
+		}
+	}
+
+	// Total band of the stack
+	idx := append([]int{}, ana.idxBkgs...)
+	if ana.SignalStack {
+		idx = append(idx, ana.idxSigs...)
+	}
+	if stack != nil && stack.Band != nil && len(idx) > 0 {
+		stack.Band.Counts = systCounts(bhistos, bsysts, idx)
+	}
+
+	if len(ana.idxBkgs) == 0 {
+		return nil
+	}
+	return systCounts(bhistos, bsysts, ana.idxBkgs)
+}
+
 // Helper function computing the ratio and adding them to the plot.
 // Both hplot and hbook histograms are needed to propagate
-// individual histo styles.
-func (ana *Maker) addRatioToPlot(rp *hplot.RatioPlot, bhistos []*hbook.H1D, phistos []*hplot.H1D) {
+// individual histo styles. If bkgCounts is not nil, the band
+// around one shows the relative uncertainties of the total
+// background it contains.
+func (ana *Maker) addRatioToPlot(rp *hplot.RatioPlot, bhistos []*hbook.H1D, phistos []*hplot.H1D, bkgCounts []hbook.Count) error {
 
 	// Do nothing if there is no background (ie only, data or only signals)
 	if len(ana.idxBkgs) == 0 {
-		return
+		return nil
 	}
 
 	// Get all histogram (hbook to compute ratio) and (hplot) for the style
@@ -404,7 +542,10 @@ func (ana *Maker) addRatioToPlot(rp *hplot.RatioPlot, bhistos []*hbook.H1D, phis
 		// MC to MC
 		hbs2d_ratioMC, err := hbook.DivideH1D(bhBkgTot, bhBkgTot, hbook.DivIgnoreNaNs())
 		if err != nil {
-			log.Fatal("cannot divide histo for the ratio plot")
+			return fmt.Errorf("could not divide histos for the ratio plot: %w", err)
+		}
+		if bkgCounts != nil {
+			hbs2d_ratioMC = relativeCounts(bkgCounts)
 		}
 		hps2d_ratioMC := hplot.NewS2D(hbs2d_ratioMC, hplot.WithBand(true),
 			hplot.WithStepsKind(hplot.HiSteps),
@@ -422,7 +563,7 @@ func (ana *Maker) addRatioToPlot(rp *hplot.RatioPlot, bhistos []*hbook.H1D, phis
 			// Data to MC
 			hbs2d_ratio, err := hbook.DivideH1D(bhData, bhBkgTot, hbook.DivIgnoreNaNs())
 			if err != nil {
-				log.Fatal("cannot divide histo for the ratio plot")
+				return fmt.Errorf("could not divide histos for the ratio plot: %w", err)
 			}
 			hps2d_ratio := hplot.NewS2D(hbs2d_ratio, hplot.WithYErrBars(true),
 				hplot.WithStepsKind(hplot.HiSteps),
@@ -442,7 +583,7 @@ func (ana *Maker) addRatioToPlot(rp *hplot.RatioPlot, bhistos []*hbook.H1D, phis
 
 			hbs2d_ratio, err := hbook.DivideH1D(h, href, hbook.DivIgnoreNaNs())
 			if err != nil {
-				log.Fatal("cannot divide histo for the ratio plot")
+				return fmt.Errorf("could not divide histos for the ratio plot: %w", err)
 			}
 
 			hps2d_ratio := hplot.NewS2D(hbs2d_ratio,
@@ -453,6 +594,8 @@ func (ana *Maker) addRatioToPlot(rp *hplot.RatioPlot, bhistos []*hbook.H1D, phis
 			rp.Bottom.Add(hps2d_ratio)
 		}
 	}
+
+	return nil
 }
 
 // Helper function returning a slice of hplot histo
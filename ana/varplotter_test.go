@@ -0,0 +1,32 @@
+package ana
+
+import "testing"
+
+// Normalizations are those of the plotted range of the first
+// variable, without its under/overflows if they are hidden.
+func TestNormalizations(t *testing.T) {
+	samples := []*Sample{
+		CreateSample("data", "data", `Data`, "../testdata/file2.root", "truth"),
+		CreateSample("bkg", "bkg", `Bkg`, "../testdata/file3.root", "truth"),
+	}
+	variables := []*Variable{
+		NewVariable("Mttbar", TreeVarF32("ttbar_m"), 10, 400, 600),
+	}
+	a, err := New(samples, variables, WithHistoNorm(true), WithFlowMode(FlowHide))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.RunEventLoops(); err != nil {
+		t.Fatal(err)
+	}
+	norms, nTot := a.Normalizations()
+	for is := range samples {
+		h := a.hbookHistos[is][0][0]
+		if got, want := norms[0][is], h.Integral(h.XMin(), h.XMax()); got != want || got == h.Integral() {
+			t.Fatalf("sample %d: got=%v, want=%v (with flows: %v)", is, got, want, h.Integral())
+		}
+	}
+	if got, want := nTot[0], norms[0][1]; got != want {
+		t.Fatalf("invalid total: got=%v, want=%v", got, want)
+	}
+}
@@ -0,0 +1,269 @@
+package ana
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/rmadar/tree-gonalyzer/internal/table"
+)
+
+// yieldCount is the number of selected events of a sample,
+// with the sums of their weights and squared weights.
+type yieldCount struct {
+	N     int64
+	SumW  float64
+	SumW2 float64
+}
+
+// Helper function adding the counts of src to y.
+func (y *yieldCount) add(src yieldCount) {
+	y.N += src.N
+	y.SumW += src.SumW
+	y.SumW2 += src.SumW2
+}
+
+// Helper function returning the yield of the counts.
+func (y yieldCount) yield() Yield {
+	return Yield{Value: y.SumW, Error: math.Sqrt(y.SumW2), N: y.N}
+}
+
+// Yield is the weighted number of events passing a
+// selection, with its statistical uncertainty.
+type Yield struct {
+	Value float64 // Sum of the event weights.
+	Error float64 // Statistical uncertainty, sqrt(sum w²).
+	N     int64   // Raw number of events.
+}
+
+// Helper function returning the sum of yields.
+func sumYields(ys ...Yield) Yield {
+	var sum, err2 float64
+	var n int64
+	for _, y := range ys {
+		sum += y.Value
+		err2 += y.Error * y.Error
+		n += y.N
+	}
+	return Yield{Value: sum, Error: math.Sqrt(err2), N: n}
+}
+
+// Helper function returning the ratio of two uncorrelated
+// yields, which is not defined (NaN) if den is zero.
+func ratioYields(num, den Yield) Yield {
+	if den.Value == 0 {
+		return Yield{Value: math.NaN(), Error: math.NaN()}
+	}
+	r := num.Value / den.Value
+	relNum, relDen := 0.0, den.Error/den.Value
+	if num.Value != 0 {
+		relNum = num.Error / num.Value
+	}
+	return Yield{Value: r, Error: math.Abs(r) * math.Hypot(relNum, relDen)}
+}
+
+// Yields contains the yields of all samples for all selections,
+// with the totals of backgrounds and signals, and the ratio of data
+// over the total prediction. They are normalized as the histograms
+// (luminosity, cross-section and number of generated events), but
+// never to unit area.
+type Yields struct {
+	Selections []string  // Names of the selections ("all" for a selection without name).
+	Samples    []string  // Names of the samples.
+	Values     [][]Yield // Yields Values[iSel][iSamp] of each sample.
+	TotalBkg   []Yield   // Total background of each selection.
+	TotalSig   []Yield   // Total signal of each selection.
+
+	// Ratio of data over the total background (and signals, if
+	// SignalStack is true) of each selection, nil without data.
+	DataOverMC []Yield
+
+	idxData []int // Indices of the data samples.
+	idxBkgs []int // Indices of the background samples.
+	idxSigs []int // Indices of the signal samples.
+}
+
+// Yields returns the yields of all samples for all selections, as
+// counted by the event loops: the sum of the event weights, with
+// the statistical uncertainty sqrt(sum w²), and the raw number of
+// events. The totals of backgrounds and signals and the data over
+// MC ratio are also computed. An error is returned if the event
+// loops were not run.
+func (ana *Maker) Yields() (Yields, error) {
+
+	if ana.histoLoaded {
+		err := fmt.Errorf("%w: histograms loaded by NewFromHistos()", ErrNoYields)
+		return Yields{}, &Error{Op: "get yields", Err: err}
+	}
+	if ana.yields == nil {
+		err := fmt.Errorf("%w: RunEventLoops() must be called before Yields()", ErrNoHistos)
+		return Yields{}, &Error{Op: "get yields", Err: err}
+	}
+
+	y := Yields{
+		Selections: make([]string, len(ana.KinemCuts)),
+		Samples:    make([]string, len(ana.Samples)),
+		Values:     make([][]Yield, len(ana.KinemCuts)),
+		TotalBkg:   make([]Yield, len(ana.KinemCuts)),
+		TotalSig:   make([]Yield, len(ana.KinemCuts)),
+		idxData:    ana.idxData,
+		idxBkgs:    ana.idxBkgs,
+		idxSigs:    ana.idxSigs,
+	}
+	for is, s := range ana.Samples {
+		y.Samples[is] = s.Name
+	}
+	if len(ana.idxData) > 0 {
+		y.DataOverMC = make([]Yield, len(ana.KinemCuts))
+	}
+
+	pick := func(ys []Yield, idx []int) []Yield {
+		res := make([]Yield, len(idx))
+		for i, j := range idx {
+			res[i] = ys[j]
+		}
+		return res
+	}
+
+	for ic, cut := range ana.KinemCuts {
+		y.Selections[ic] = selectionDir(cut)
+		y.Values[ic] = make([]Yield, len(ana.Samples))
+		for is := range ana.Samples {
+			y.Values[ic][is] = ana.yields[is][ic].yield()
+		}
+		y.TotalBkg[ic] = sumYields(pick(y.Values[ic], ana.idxBkgs)...)
+		y.TotalSig[ic] = sumYields(pick(y.Values[ic], ana.idxSigs)...)
+		if y.DataOverMC != nil {
+			mc := y.TotalBkg[ic]
+			if ana.SignalStack {
+				mc = sumYields(mc, y.TotalSig[ic])
+			}
+			data := y.Values[ic][ana.idxData[0]]
+			y.DataOverMC[ic] = ratioYields(data, mc)
+		}
+	}
+
+	return y, nil
+}
+
+// yieldRow is a row of a yield table, with one
+// yield per selection.
+type yieldRow struct {
+	name  string
+	ys    []Yield
+	total bool // Total or ratio, rather than a sample.
+	ratio bool
+}
+
+// Helper function returning the rows of the yield tables,
+// by groups: backgrounds and their total, signals and their
+// total, data and the data over MC ratio.
+func (y Yields) rows() [][]yieldRow {
+
+	sample := func(is int) yieldRow {
+		r := yieldRow{name: y.Samples[is], ys: make([]Yield, len(y.Selections))}
+		for ic := range y.Selections {
+			r.ys[ic] = y.Values[ic][is]
+		}
+		return r
+	}
+
+	groups := [][]yieldRow{}
+	add := func(idx []int, name string, total []Yield, ratio bool) {
+		if len(idx) == 0 {
+			return
+		}
+		g := []yieldRow{}
+		for _, is := range idx {
+			g = append(g, sample(is))
+		}
+		groups = append(groups, g)
+		if total != nil {
+			groups = append(groups, []yieldRow{{name: name, ys: total, total: true, ratio: ratio}})
+		}
+	}
+	add(y.idxBkgs, "Total background", y.TotalBkg, false)
+	add(y.idxSigs, "Total signal", y.TotalSig, false)
+	add(y.idxData, "Data / MC", y.DataOverMC, true)
+
+	return groups
+}
+
+// Helper function returning the yield table, with one row
+// per sample (and totals) and one column per selection.
+func (y Yields) table() table.Table {
+	t := table.Table{Header: append([]string{"Sample"}, y.Selections...)}
+	for _, g := range y.rows() {
+		rows := make([]table.Row, len(g))
+		for i, r := range g {
+			rows[i] = table.Row{Cells: []table.Cell{table.Text(r.name)}, Bold: r.total}
+			for _, yi := range r.ys {
+				prec := 2
+				if r.ratio {
+					prec = 3
+				}
+				rows[i].Cells = append(rows[i].Cells, yieldCell(yi, prec))
+			}
+		}
+		t.Groups = append(t.Groups, rows)
+	}
+	return t
+}
+
+// Helper function returning the cell of a yield, "value ± error"
+// with prec decimals. Non-finite values are written as "-".
+func yieldCell(y Yield, prec int) table.Cell {
+	if math.IsNaN(y.Value) || math.IsInf(y.Value, 0) {
+		return table.Text("-")
+	}
+	return table.Sym(y.Value, y.Error, prec)
+}
+
+// WriteASCII writes the yield table as aligned text, with one
+// row per sample (and totals) and one column per selection.
+func (y Yields) WriteASCII(w io.Writer) error {
+	return y.table().WriteASCII(w)
+}
+
+// WriteMarkdown writes the yield table in Markdown, with one
+// row per sample (and totals) and one column per selection.
+func (y Yields) WriteMarkdown(w io.Writer) error {
+	return y.table().WriteMarkdown(w)
+}
+
+// WriteLaTeX writes the yield table as a LaTeX tabular, with one
+// row per sample (and totals) and one column per selection.
+func (y Yields) WriteLaTeX(w io.Writer) error {
+	return y.table().WriteLaTeX(w)
+}
+
+// WriteCSV writes the yields as CSV, with one record per
+// selection and sample (or total): selection, sample, yield,
+// statistical uncertainty and raw number of events.
+func (y Yields) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"selection", "sample", "yield", "error", "entries"})
+	f64 := func(x float64) string { return strconv.FormatFloat(x, 'g', -1, 64) }
+	for ic, sel := range y.Selections {
+		for _, g := range y.rows() {
+			for _, r := range g {
+				yi := r.ys[ic]
+				cw.Write([]string{sel, r.name, f64(yi.Value), f64(yi.Error), strconv.FormatInt(yi.N, 10)})
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Helper function saving the yield tables in SavePath, in
+// all formats.
+func (ana *Maker) saveYields() error {
+	y, err := ana.Yields()
+	if err != nil {
+		return err
+	}
+	return ana.saveTable("save yields", "yields", y)
+}
@@ -1,8 +1,8 @@
 package cflow
 
 import (
-	"log"
-	
+	"fmt"
+
 	"go-hep.org/x/hep/groot"
 	"go-hep.org/x/hep/groot/rtree"
 )
@@ -31,21 +31,76 @@ type Analysis struct {
 
 	// Name of the TTree to be analyzed.
 	TreeName string
+
+	// Channels forking the cut flow after the cut sequence,
+	// each with its own cut sequence, counted in the same
+	// event loop.
+	Channels []Channel
+
+	// NMinusOne enables the N-1 efficiencies of the cuts,
+	// ie the efficiency of each cut for the events passing
+	// all the other cuts. All cuts are then evaluated for
+	// each event.
+	NMinusOne bool
+}
+
+// Channel is a branch of a cut flow, with its own
+// sequence of cumulated cuts.
+type Channel struct {
+	Name string // Name of the channel.
+	Cuts []Cut  // Cut sequence of the channel.
+}
+
+// Result contains the cut flow of an analysis, the cut
+// flows of its channels and its N-1 efficiencies.
+type Result struct {
+
+	// Cut flow of the cut sequence, starting with the
+	// preselection, if any.
+	CutFlow CutFlow `json:"cut_flow"`
+
+	// Cut flows of the channels, made of the stages of
+	// the common cut flow followed by those of each channel.
+	Channels []ChannelFlow `json:"channels,omitempty"`
+
+	// N-1 efficiencies of the cut sequence, nil if not
+	// enabled.
+	NMinusOne NMinusOneTable `json:"n_minus_one,omitempty"`
+}
+
+// ChannelFlow is the cut flow of a channel.
+type ChannelFlow struct {
+	Name    string  `json:"name"`
+	CutFlow CutFlow `json:"cut_flow"`
 }
 
 // Run executes the event loop in order to count raw
-// and weighted yields. The final cutflow is printed
-// in this function, after the event loop. The typical
-// output of this function is:
-//  | Cut name              | Raw Yields                 | Weighted Yields            |
-//  |                       |                 Abs    Rel |                 Abs    Rel |
-//  |-----------------------|----------------------------|----------------------------|
-//  | Electron channel      |         5526   100%   100% |     28230.59   100%   100% |
-//  | pT > 10 GeV           |         5281    96%    96% |     28065.97    99%    99% |
-//  | Phi < 2.0 rad         |         4312    78%    82% |     22874.73    81%    82% |
-//
-func (ana *Analysis) Run() {
-	
+// and weighted yields, and returns the cut flow with
+// the efficiencies of each cut stage. An error is
+// returned if the files or the tree cannot be read.
+// The cut flow can then be printed, or written in
+// several formats.
+func (ana *Analysis) Run() (CutFlow, error) {
+	res, err := ana.RunAll()
+	return res.CutFlow, err
+}
+
+// RunAll executes the event loop as Run does, and
+// returns the cut flows of the channels and the N-1
+// efficiencies together with the cut flow.
+func (ana *Analysis) RunAll() (Result, error) {
+	if ana.EventModel == nil {
+		return Result{}, fmt.Errorf("cflow: no event model")
+	}
+	return ana.run(*ana.EventModel, nil)
+}
+
+// run executes the event loop over the chained trees of
+// the files, counting the yields of all the stages. The
+// event weight is given by weight, if not nil, and by
+// evt.Weight() otherwise.
+func (ana *Analysis) run(evt Evt, weight func(e Evt) float64) (Result, error) {
+
 	// Full rtree
 	var tree rtree.Tree
 
@@ -55,48 +110,67 @@ func (ana *Analysis) Run() {
 		// Open the file
 		f, err := groot.Open(fName)
 		if err != nil {
-			log.Fatal(err)
+			return Result{}, fmt.Errorf("cflow: could not open file: %w", err)
 		}
 		defer f.Close()
-		
+
 		// Get the tree
 		obj, err := f.Get(ana.TreeName)
 		if err != nil {
-			log.Fatal(err)
+			return Result{}, fmt.Errorf("cflow: could not get tree from %q: %w", fName, err)
+		}
+		t, ok := obj.(rtree.Tree)
+		if !ok {
+			return Result{}, fmt.Errorf("cflow: object %q in %q is not a tree", ana.TreeName, fName)
 		}
-		t := obj.(rtree.Tree)
 
 		// Chain to the full tree
 		switch iFile {
 		case 0:
 			tree = t
 		default:
-			tree = rtree.Chain(tree, t) 
+			tree = rtree.Chain(tree, t)
 		}
 	}
-	
-	// Event 
-	evt := *ana.EventModel
+	if tree == nil {
+		return Result{}, fmt.Errorf("cflow: no input file")
+	}
+
+	// Event weight
+	if weight == nil {
+		weight = func(e Evt) float64 { return e.Weight() }
+	}
 
 	// Variables to read.
-	vars  := evt.Vars()
+	vars := evt.Vars()
 	rvars := make([]rtree.ReadVar, len(vars))
 	for i, v := range vars {
 		rvars[i] = rtree.ReadVar{Name: v.Name, Value: v.Value}
 	}
-	
+
 	// Tree reader
-        r, err := rtree.NewReader(tree, rvars)  
-        if err != nil {                                               
-                log.Fatalf("could not create tree reader: %+v", err)  
-        }                                                             
-        defer r.Close()
-	
+	r, err := rtree.NewReader(tree, rvars)
+	if err != nil {
+		return Result{}, fmt.Errorf("cflow: could not create tree reader: %w", err)
+	}
+	defer r.Close()
+
 	// Cutflow corresponding to the slice of cuts.
-	cutFlow := newCutFlow(ana.Cuts)
+	cutFlow := ana.stages()
+	off := len(cutFlow) - len(ana.Cuts)
+
+	// Channels and N-1 yields.
+	chFlows := make([]CutFlow, len(ana.Channels))
+	for i, ch := range ana.Channels {
+		chFlows[i] = cutStages(ch.Cuts)
+	}
+	var others []Yields
+	if ana.NMinusOne {
+		others = make([]Yields, len(ana.Cuts))
+	}
 
 	// Loop over events
-        err = r.Read(func(ctx rtree.RCtx) error {  
+	err = r.Read(func(ctx rtree.RCtx) error {
 
 		// Apply preselection if any
 		if ana.Preselection != nil {
@@ -104,20 +178,80 @@ func (ana *Analysis) Run() {
 				return nil
 			}
 		}
-		
-		// Loop over the cuts and cumulate them.
-		pass := true
+		w := weight(evt)
+		if ana.Preselection != nil {
+			cutFlow[0].add(w)
+		}
+
+		// Loop over the cuts and cumulate them. For N-1
+		// efficiencies, cuts are evaluated until a second
+		// one fails.
+		nFail, iFail := 0, -1
 		for ic, cut := range ana.Cuts {
-			pass = pass && cut.Sel(evt)
-			if pass {
-				cutFlow[ic].Raw += 1
-				cutFlow[ic].Wgt += evt.Weight()
+			if !cut.Sel(evt) {
+				nFail++
+				iFail = ic
+				if !ana.NMinusOne || nFail > 1 {
+					break
+				}
+				continue
+			}
+			if nFail == 0 {
+				cutFlow[off+ic].add(w)
+			}
+		}
+
+		// Events passing all others cuts.
+		switch {
+		case others == nil || nFail > 1:
+		case nFail == 1:
+			others[iFail].add(w)
+		default:
+			for ic := range others {
+				others[ic].add(w)
 			}
 		}
-		
+
+		// Channels, after the full cut sequence.
+		if nFail > 0 {
+			return nil
+		}
+		for i, ch := range ana.Channels {
+			for ic, cut := range ch.Cuts {
+				if !cut.Sel(evt) {
+					break
+				}
+				chFlows[i][ic].add(w)
+			}
+		}
+
 		return nil
 	})
-	
-	// Print the result
-        cutFlow.Print()
+	if err != nil {
+		return Result{}, fmt.Errorf("cflow: could not read tree: %w", err)
+	}
+
+	// Compute the efficiencies
+	res := Result{CutFlow: cutFlow}
+	for i, ch := range ana.Channels {
+		cf := append(append(CutFlow{}, cutFlow...), chFlows[i]...)
+		cf.computeEffs()
+		res.Channels = append(res.Channels, ChannelFlow{Name: ch.Name, CutFlow: cf})
+	}
+	if len(others) > 0 {
+		res.NMinusOne = newNMinusOneTable(cutFlow[len(cutFlow)-1], ana.Cuts, others)
+	}
+	cutFlow.computeEffs()
+
+	return res, nil
+}
+
+// stages returns the empty cut flow of the cut sequence,
+// the first stage being the preselection, if any.
+func (ana *Analysis) stages() CutFlow {
+	cf := cutStages(ana.Cuts)
+	if ana.Preselection != nil {
+		cf = append(CutFlow{{Name: "Preselection"}}, cf...)
+	}
+	return cf
 }
@@ -1,34 +1,74 @@
 // cflow package exposes types allowing to
-// perform a cut flow on a single file.
+// perform a cut flow on a single sample, or
+// side by side on several samples.
 package cflow
 
 import (
-	"fmt"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"os"
+	"strconv"
+
+	"github.com/rmadar/tree-gonalyzer/internal/table"
+	"gonum.org/v1/gonum/stat/distuv"
 )
 
 // Event model interface
-type Evt interface{
-	Vars()   []Var   // Return the list of needed variables.
+type Evt interface {
+	Vars() []Var     // Return the list of needed variables.
 	Weight() float64 // Define the weight (from available variables).
 }
 
 // TreeVar groups the branch name and a value
 // of the proper type, as needed by rtree.ReadVar.
 type Var struct {
-	Name string        // Name of the branch
-	Value interface{}  // Pointer of the same type of the stored branch.
+	Name  string      // Name of the branch
+	Value interface{} // Pointer of the same type of the stored branch.
+}
+
+// Yields type with both raw and weighted yields
+// of a cut stage, and their efficiencies.
+type Yields struct {
+	Name   string  `json:"name"`    // Name of the cut stage.
+	Raw    float64 `json:"raw"`     // Raw yields.
+	Wgt    float64 `json:"wgt"`     // Weighted yields, as defined by Evt.weight()
+	Wgt2   float64 `json:"wgt2"`    // Sum of squared weights.
+	AbsEff Eff     `json:"abs_eff"` // Efficiency with respect to the first cut stage.
+	RelEff Eff     `json:"rel_eff"` // Efficiency with respect to the previous cut stage.
+}
+
+// WgtErr returns the statistical uncertainty of the
+// weighted yields, sqrt(sum w²).
+func (y Yields) WgtErr() float64 {
+	return math.Sqrt(y.Wgt2)
+}
+
+// add counts an event of weight w.
+func (y *Yields) add(w float64) {
+	y.Raw++
+	y.Wgt += w
+	y.Wgt2 += w * w
 }
 
-// Event yields type with both raw and
-// weighted yields, and a name for a cut stage.
-type yields struct {
-	Name  string  // Name of the cut stage.
-	Raw   float64 // Raw yields.
-	Wgt   float64 // Weighted yields, as defined by Evt.weight()
+// Eff type with both raw and weighted efficiencies,
+// as fractions, and their uncertainties. They are
+// zero if the reference yield is. The uncertainties
+// of raw efficiencies are given by the 68.3% Clopper-
+// Pearson interval, as distances to the efficiency.
+// The uncertainty of weighted efficiencies is the
+// binomial one, generalized to weighted events.
+type Eff struct {
+	Raw      float64 `json:"raw"`        // Efficiency of raw yields.
+	RawErrLo float64 `json:"raw_err_lo"` // Lower uncertainty of raw efficiency.
+	RawErrUp float64 `json:"raw_err_up"` // Upper uncertainty of raw efficiency.
+	Wgt      float64 `json:"wgt"`        // Efficiency of weighted yields.
+	WgtErr   float64 `json:"wgt_err"`    // Uncertainty of weighted efficiency.
 }
 
-// cutFlow is a slice of Yields, once per cut.
-type cutFlow []yields
+// CutFlow is a slice of Yields, once per cut.
+type CutFlow []Yields
 
 // Cut contains the needed information
 type Cut struct {
@@ -36,48 +76,173 @@ type Cut struct {
 	Sel  func(e Evt) bool // Function defining the cut.
 }
 
-// newCutFlow creates a CutFlow object corresponding
+// NewCutFlow returns the cut flow of the given cut stages,
+// with their names and yields, computing their efficiencies.
+func NewCutFlow(stages []Yields) CutFlow {
+	cf := append(CutFlow{}, stages...)
+	cf.computeEffs()
+	return cf
+}
+
+// cutStages creates an empty CutFlow object corresponding
 // to a given cut sequence.
-func newCutFlow(cuts []Cut) cutFlow {
-	cf := make([]yields, len(cuts))
+func cutStages(cuts []Cut) CutFlow {
+	cf := make(CutFlow, len(cuts))
 	for i, cut := range cuts {
 		cf[i].Name = cut.Name
 	}
 	return cf
 }
 
-// Print outputs nicely the result
-func (cf cutFlow) Print() {
+// computeEffs fills the efficiencies of each cut stage.
+func (cf CutFlow) computeEffs() {
+	for i := range cf {
+		// The first stage is the reference of itself, without uncertainty.
+		if i == 0 {
+			eff := Eff{Raw: ratio(cf[0].Raw, cf[0].Raw), Wgt: ratio(cf[0].Wgt, cf[0].Wgt)}
+			cf[i].AbsEff, cf[i].RelEff = eff, eff
+			continue
+		}
+		cf[i].AbsEff = efficiency(cf[i], cf[0])
+		cf[i].RelEff = efficiency(cf[i], cf[i-1])
+	}
+}
+
+// ratio returns x/xref, or zero if xref is zero.
+func ratio(x, xref float64) float64 {
+	if xref == 0 {
+		return 0
+	}
+	return x / xref
+}
+
+// efficiency returns the efficiency of y with respect to
+// yref, the events of y being a subset of those of yref.
+func efficiency(y, yref Yields) Eff {
 
-	// Table header
-	ul25 := "--------------------------"
-	ul30 := "-------------------------------"
-	fmt.Printf("\n| %-25s| %-30s| %-30s|\n", "Cut name", "Raw Yields", "Weighted Yields")
-	fmt.Printf(  "| %-25s| %22s %6s | %22s %6s |\n", "", "Abs", "Rel", "Abs", "Rel")
-	fmt.Printf("|%s|%s|%s|\n", ul25, ul30, ul30)
+	var eff Eff
 
-	// Print each cut yields
+	// Raw efficiency, with Clopper-Pearson interval.
+	if yref.Raw > 0 {
+		eff.Raw = y.Raw / yref.Raw
+		lo, up := clopperPearson(y.Raw, yref.Raw, 0.6827)
+		eff.RawErrLo, eff.RawErrUp = eff.Raw-lo, up-eff.Raw
+	}
+
+	// Weighted efficiency: passing events contribute with (1-e)
+	// to the variance, failing ones with e, which gives
+	// V = ((1-2e)*sum_pass w² + e²*sum_all w²) / (sum_all w)².
+	if yref.Wgt != 0 {
+		e := y.Wgt / yref.Wgt
+		v := ((1-2*e)*y.Wgt2 + e*e*yref.Wgt2) / (yref.Wgt * yref.Wgt)
+		eff.Wgt, eff.WgtErr = e, math.Sqrt(math.Max(v, 0))
+	}
+
+	return eff
+}
+
+// clopperPearson returns the Clopper-Pearson interval of
+// the efficiency k/n, at the confidence level cl.
+func clopperPearson(k, n, cl float64) (lo, up float64) {
+	alpha := (1 - cl) / 2
+	lo, up = 0, 1
+	if k > 0 {
+		lo = distuv.Beta{Alpha: k, Beta: n - k + 1}.Quantile(alpha)
+	}
+	if k < n {
+		up = distuv.Beta{Alpha: k + 1, Beta: n - k}.Quantile(1 - alpha)
+	}
+	return lo, up
+}
+
+// Print outputs nicely the result
+func (cf CutFlow) Print() {
+	cf.WriteASCII(os.Stdout)
+}
+
+// table returns the table of the cut flow, with one row
+// per cut stage, and efficiencies in percent.
+func (cf CutFlow) table() table.Table {
+	rows := make([]table.Row, len(cf))
 	for i, y := range cf {
-		var yref yields
-		switch i {
-		case 0 : yref = cf[0]
-		default: yref = cf[i-1]
+		rows[i].Cells = []table.Cell{
+			table.Text(y.Name),
+			table.Value(y.Raw, 0),
+			table.Asym(y.AbsEff.Raw*100, y.AbsEff.RawErrLo*100, y.AbsEff.RawErrUp*100, 1),
+			table.Asym(y.RelEff.Raw*100, y.RelEff.RawErrLo*100, y.RelEff.RawErrUp*100, 1),
+			table.Sym(y.Wgt, y.WgtErr(), 2),
+			table.Sym(y.AbsEff.Wgt*100, y.AbsEff.WgtErr*100, 1),
+			table.Sym(y.RelEff.Wgt*100, y.RelEff.WgtErr*100, 1),
 		}
-		absEff := efficiency(y, cf[0])
-		relEff := efficiency(y, yref )
-		fmt.Printf("| %-25s|%16.0f  %4.0f%%  %4.0f%% |%16.2f  %4.0f%%  %4.0f%% |\n",
-			y.Name,
-			y.Raw, absEff.Raw, relEff.Raw, 
-			y.Wgt, absEff.Wgt, relEff.Wgt, 
-		)
 	}
-	fmt.Printf("\n")
+	return table.Table{Header: []string{
+		"Cut name",
+		"Raw yields", "Abs. eff. (%)", "Rel. eff. (%)",
+		"Weighted yields", "Abs. eff. (%)", "Rel. eff. (%)",
+	}, Groups: [][]table.Row{rows}}
+}
+
+// WriteASCII writes the cut flow as an ASCII table, with
+// raw and weighted yields and their efficiencies, eg:
+//
+//	| Cut name         | Raw yields |   Abs. eff. (%) |   Rel. eff. (%) |   Weighted yields | Abs. eff. (%) | Rel. eff. (%) |
+//	|------------------|------------|-----------------|-----------------|-------------------|---------------|---------------|
+//	| Electron channel |       3581 | 100.0 +0.0/-0.0 | 100.0 +0.0/-0.0 | 18731.59 ± 383.87 |   100.0 ± 0.0 |   100.0 ± 0.0 |
+//	| pT > 10 GeV      |       3490 |  97.5 +0.3/-0.3 |  97.5 +0.3/-0.3 | 18672.52 ± 383.81 |    99.7 ± 0.0 |    99.7 ± 0.0 |
+//	| Phi < 2.0 rad    |       2845 |  79.4 +0.7/-0.7 |  81.5 +0.7/-0.7 | 15226.73 ± 345.13 |    81.3 ± 0.8 |    81.5 ± 0.8 |
+func (cf CutFlow) WriteASCII(w io.Writer) error {
+	return cf.table().WriteASCII(w)
 }
 
-func efficiency(y, yref yields) yields {
-	return yields{
-		Name: y.Name,
-		Raw:  y.Raw / yref.Raw * 100,
-		Wgt:  y.Wgt / yref.Wgt * 100,
+// WriteMarkdown writes the cut flow as a Markdown table,
+// with one row per cut stage.
+func (cf CutFlow) WriteMarkdown(w io.Writer) error {
+	return cf.table().WriteMarkdown(w)
+}
+
+// WriteLaTeX writes the cut flow as a LaTeX tabular,
+// with one row per cut stage.
+func (cf CutFlow) WriteLaTeX(w io.Writer) error {
+	return cf.table().WriteLaTeX(w)
+}
+
+// WriteCSV writes the cut flow as CSV, with one record
+// per cut stage and efficiencies as fractions.
+func (cf CutFlow) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{
+		"cut",
+		"raw", "raw_abs_eff", "raw_abs_eff_err_lo", "raw_abs_eff_err_up",
+		"raw_rel_eff", "raw_rel_eff_err_lo", "raw_rel_eff_err_up",
+		"wgt", "wgt_err", "wgt_abs_eff", "wgt_abs_eff_err", "wgt_rel_eff", "wgt_rel_eff_err",
+	})
+	for _, y := range cf {
+		cw.Write([]string{
+			y.Name,
+			f64(y.Raw), f64(y.AbsEff.Raw), f64(y.AbsEff.RawErrLo), f64(y.AbsEff.RawErrUp),
+			f64(y.RelEff.Raw), f64(y.RelEff.RawErrLo), f64(y.RelEff.RawErrUp),
+			f64(y.Wgt), f64(y.WgtErr()), f64(y.AbsEff.Wgt), f64(y.AbsEff.WgtErr), f64(y.RelEff.Wgt), f64(y.RelEff.WgtErr),
+		})
 	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// f64 formats a float64 for CSV outputs.
+func f64(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+// WriteJSON writes the cut flow as an indented JSON
+// array, with one object per cut stage.
+func (cf CutFlow) WriteJSON(w io.Writer) error {
+	return writeJSON(w, cf)
+}
+
+// writeJSON writes v as indented JSON, without escaping HTML.
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(v)
 }
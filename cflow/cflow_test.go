@@ -0,0 +1,139 @@
+package cflow
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestClopperPearson(t *testing.T) {
+	const cl = 0.6827
+	alpha := (1 - cl) / 2
+	for _, tc := range []struct {
+		k, n   float64
+		lo, up float64
+	}{
+		{k: 0, n: 10, lo: 0, up: 1 - math.Pow(alpha, 1./10)},
+		{k: 10, n: 10, lo: math.Pow(alpha, 1./10), up: 1},
+		{k: 1, n: 1, lo: alpha, up: 1},
+	} {
+		lo, up := clopperPearson(tc.k, tc.n, cl)
+		if math.Abs(lo-tc.lo) > 1e-9 || math.Abs(up-tc.up) > 1e-9 {
+			t.Errorf("k=%v, n=%v: got [%v, %v], want [%v, %v]", tc.k, tc.n, lo, up, tc.lo, tc.up)
+		}
+	}
+
+	// Interval around the efficiency, close to the binomial one.
+	lo, up := clopperPearson(800, 1000, cl)
+	if !(lo < 0.8 && 0.8 < up) {
+		t.Fatalf("invalid interval [%v, %v]", lo, up)
+	}
+	if want := math.Sqrt(0.8 * 0.2 / 1000); math.Abs((up-lo)/2-want) > 1e-3 {
+		t.Errorf("half width: got %v, want %v", (up-lo)/2, want)
+	}
+}
+
+func TestEfficiency(t *testing.T) {
+
+	// Unit weights give the binomial uncertainty.
+	pass := Yields{Raw: 800, Wgt: 800, Wgt2: 800}
+	all := Yields{Raw: 1000, Wgt: 1000, Wgt2: 1000}
+	eff := efficiency(pass, all)
+	if eff.Raw != 0.8 || eff.Wgt != 0.8 {
+		t.Fatalf("invalid efficiencies: %+v", eff)
+	}
+	if want := math.Sqrt(0.8 * 0.2 / 1000); math.Abs(eff.WgtErr-want) > 1e-12 {
+		t.Errorf("weighted uncertainty: got %v, want %v", eff.WgtErr, want)
+	}
+	if eff.RawErrLo <= 0 || eff.RawErrUp <= 0 {
+		t.Errorf("invalid raw uncertainties: %+v", eff)
+	}
+
+	// Zero reference.
+	if eff := efficiency(Yields{}, Yields{}); eff != (Eff{}) {
+		t.Errorf("zero reference: got %+v", eff)
+	}
+
+	// First stage, without uncertainty.
+	cf := CutFlow{all, pass}
+	cf.computeEffs()
+	if want := (Eff{Raw: 1, Wgt: 1}); cf[0].AbsEff != want || cf[0].RelEff != want {
+		t.Errorf("first stage: got %+v, %+v", cf[0].AbsEff, cf[0].RelEff)
+	}
+}
+
+// The CSV and JSON outputs give back the values of the cut
+// flow, up to rounding errors.
+func TestCutFlowWriters(t *testing.T) {
+	cf := CutFlow{
+		{Name: "all", Raw: 1000, Wgt: 520.5, Wgt2: 301.25},
+		{Name: "pT > 10", Raw: 800, Wgt: 410.1, Wgt2: 230.75},
+		{Name: "eta < 2.5", Raw: 640, Wgt: 333.3, Wgt2: 190.5},
+	}
+	cf.computeEffs()
+
+	near := func(x, y float64) bool {
+		return math.Abs(x-y) <= 1e-9*math.Max(1, math.Max(math.Abs(x), math.Abs(y)))
+	}
+
+	// CSV
+	var buf bytes.Buffer
+	if err := cf.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	recs, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %+v", err)
+	}
+	if got, want := len(recs), len(cf)+1; got != want {
+		t.Fatalf("invalid number of CSV records: got=%d, want=%d", got, want)
+	}
+	for i, y := range cf {
+		rec := recs[i+1]
+		want := []float64{
+			y.Raw, y.AbsEff.Raw, y.AbsEff.RawErrLo, y.AbsEff.RawErrUp,
+			y.RelEff.Raw, y.RelEff.RawErrLo, y.RelEff.RawErrUp,
+			y.Wgt, y.WgtErr(), y.AbsEff.Wgt, y.AbsEff.WgtErr, y.RelEff.Wgt, y.RelEff.WgtErr,
+		}
+		if rec[0] != y.Name || len(rec) != len(want)+1 {
+			t.Fatalf("invalid CSV record %v", rec)
+		}
+		for k, w := range want {
+			got, err := strconv.ParseFloat(rec[k+1], 64)
+			if err != nil || !near(got, w) {
+				t.Errorf("%s: CSV column %s: got=%s, want=%v", y.Name, recs[0][k+1], rec[k+1], w)
+			}
+		}
+	}
+
+	// JSON
+	buf.Reset()
+	if err := cf.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var got CutFlow
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %+v", err)
+	}
+	if len(got) != len(cf) {
+		t.Fatalf("invalid number of JSON cut stages: got=%d, want=%d", len(got), len(cf))
+	}
+	for i, y := range cf {
+		g := got[i]
+		if g.Name != y.Name || !near(g.Raw, y.Raw) || !near(g.Wgt, y.Wgt) || !near(g.Wgt2, y.Wgt2) {
+			t.Errorf("%s: invalid JSON yields: got %+v", y.Name, g)
+		}
+		for _, e := range [][2]Eff{{g.AbsEff, y.AbsEff}, {g.RelEff, y.RelEff}} {
+			if !near(e[0].Raw, e[1].Raw) || !near(e[0].RawErrLo, e[1].RawErrLo) || !near(e[0].RawErrUp, e[1].RawErrUp) ||
+				!near(e[0].Wgt, e[1].Wgt) || !near(e[0].WgtErr, e[1].WgtErr) {
+				t.Errorf("%s: invalid JSON efficiency: got %+v, want %+v", y.Name, e[0], e[1])
+			}
+		}
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"pT > 10"`)) {
+		t.Errorf("HTML characters are escaped in JSON:\n%s", buf.Bytes())
+	}
+}
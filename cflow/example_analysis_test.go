@@ -1,6 +1,10 @@
 package cflow_test
 
 import (
+	"fmt"
+	"log"
+	"os"
+
 	"github.com/rmadar/tree-gonalyzer/cflow"
 )
 
@@ -72,15 +76,86 @@ func ExampleAnalysis_basicCutFlow() {
 	}
 
 	// Run the cutflow
-	ana.Run()
+	cf, err := ana.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Print the yields of the cuts following the preselection
+	for _, y := range cf[1:] {
+		fmt.Printf("%-16s %5.0f %9.2f\n", y.Name, y.Raw, y.Wgt)
+	}
 
 	// Output:
-	// | Cut name                 | Raw Yields                    | Weighted Yields               |
-	// |                          |                    Abs    Rel |                    Abs    Rel |
-	// |--------------------------|-------------------------------|-------------------------------|
-	// | Electron channel         |            5526   100%   100% |        28230.59   100%   100% |
-	// | pT > 10 GeV              |            5281    96%    96% |        28065.97    99%    99% |
-	// | Phi < 2.0 rad            |            4312    78%    82% |        22874.73    81%    82% |
+	// Electron channel  5526  28230.59
+	// pT > 10 GeV       5281  28065.97
+	// Phi < 2.0 rad     4312  22874.73
 }
 
+func ExampleCutFlow_renderers() {
+
+	// User-defined event model, based on cflow.Evt interface.
+	var e cflow.Evt = &usrEvt{}
+
+	// Define the cutflow analyzer
+	ana := cflow.Analysis{
+		EventModel:   &e,
+		Preselection: presel,
+		Cuts: []cflow.Cut{
+			{Name: "Electron channel", Sel: cut0},
+			{Name: "pT > 10 GeV", Sel: cut1},
+			{Name: "Phi < 2.0 rad", Sel: cut2},
+		},
+		FilesName: []string{"../testdata/file2.root", "../testdata/file3.root"},
+		TreeName:  "truth",
+	}
 
+	// Run the cutflow
+	cf, err := ana.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Use the result
+	last := cf[len(cf)-1]
+	fmt.Printf("%s: %.0f events (%.1f%%)\n", last.Name, last.Raw, last.AbsEff.Raw*100)
+
+	// Write it as ASCII and Markdown tables, or similarly
+	// with WriteLaTeX, WriteCSV and WriteJSON.
+	cf.Print()
+	cf.WriteMarkdown(os.Stdout)
+
+	// Output:
+	// Phi < 2.0 rad: 2845 events (28.4%)
+	//
+	// | Cut name         | Raw yields |   Abs. eff. (%) |   Rel. eff. (%) |   Weighted yields | Abs. eff. (%) | Rel. eff. (%) |
+	// |------------------|------------|-----------------|-----------------|-------------------|---------------|---------------|
+	// | Preselection     |      10025 | 100.0 +0.0/-0.0 | 100.0 +0.0/-0.0 | 53643.26 ± 655.89 |   100.0 ± 0.0 |   100.0 ± 0.0 |
+	// | Electron channel |       3581 |  35.7 +0.5/-0.5 |  35.7 +0.5/-0.5 | 18731.59 ± 383.87 |    34.9 ± 0.6 |    34.9 ± 0.6 |
+	// | pT > 10 GeV      |       3490 |  34.8 +0.5/-0.5 |  97.5 +0.3/-0.3 | 18672.52 ± 383.81 |    34.8 ± 0.6 |    99.7 ± 0.0 |
+	// | Phi < 2.0 rad    |       2845 |  28.4 +0.5/-0.5 |  81.5 +0.7/-0.7 | 15226.73 ± 345.13 |    28.4 ± 0.5 |    81.5 ± 0.8 |
+	//
+	// | Cut name | Raw yields | Abs. eff. (%) | Rel. eff. (%) | Weighted yields | Abs. eff. (%) | Rel. eff. (%) |
+	// |:---|---:|---:|---:|---:|---:|---:|
+	// | Preselection | 10025 | 100.0 +0.0/-0.0 | 100.0 +0.0/-0.0 | 53643.26 ± 655.89 | 100.0 ± 0.0 | 100.0 ± 0.0 |
+	// | Electron channel | 3581 | 35.7 +0.5/-0.5 | 35.7 +0.5/-0.5 | 18731.59 ± 383.87 | 34.9 ± 0.6 | 34.9 ± 0.6 |
+	// | pT > 10 GeV | 3490 | 34.8 +0.5/-0.5 | 97.5 +0.3/-0.3 | 18672.52 ± 383.81 | 34.8 ± 0.6 | 99.7 ± 0.0 |
+	// | Phi < 2.0 rad | 2845 | 28.4 +0.5/-0.5 | 81.5 +0.7/-0.7 | 15226.73 ± 345.13 | 28.4 ± 0.5 | 81.5 ± 0.8 |
+}
+
+func ExampleAnalysis_Run_error() {
+
+	var e cflow.Evt = &usrEvt{}
+	ana := cflow.Analysis{
+		EventModel: &e,
+		FilesName:  []string{"../testdata/file2.root"},
+		TreeName:   "missing",
+	}
+
+	// Errors are returned, rather than fatal.
+	_, err := ana.Run()
+	fmt.Println(err != nil)
+
+	// Output:
+	// true
+}
@@ -0,0 +1,88 @@
+package cflow_test
+
+import (
+	"log"
+
+	"github.com/rmadar/tree-gonalyzer/cflow"
+)
+
+func ExampleAnalysis_RunAll() {
+
+	// User-defined event model, based on cflow.Evt interface.
+	var e cflow.Evt = &usrEvt{}
+
+	// Lepton flavour and transverse momentum cuts
+	flavour := func(pid int32) func(e cflow.Evt) bool {
+		return func(e cflow.Evt) bool { return e.(*usrEvt).pid == pid }
+	}
+	ptMin := func(pt float32) func(e cflow.Evt) bool {
+		return func(e cflow.Evt) bool { return e.(*usrEvt).pt > pt }
+	}
+
+	// Define the cutflow analyzer, with a common cut sequence
+	// forking into an electron and a muon channels.
+	ana := cflow.Analysis{
+		EventModel:   &e,
+		Preselection: ptMin(10),
+		Cuts: []cflow.Cut{
+			{Name: "Eta > 0.5", Sel: cut0},
+			{Name: "Phi < 2.0 rad", Sel: cut2},
+		},
+		Channels: []cflow.Channel{
+			{Name: "electron", Cuts: []cflow.Cut{
+				{Name: "Electron", Sel: flavour(11)},
+				{Name: "pT > 50 GeV", Sel: ptMin(50)},
+			}},
+			{Name: "muon", Cuts: []cflow.Cut{
+				{Name: "Muon", Sel: flavour(13)},
+				{Name: "pT > 50 GeV", Sel: ptMin(50)},
+			}},
+		},
+		NMinusOne: true,
+		FilesName: []string{"../testdata/file2.root", "../testdata/file3.root"},
+		TreeName:  "truth",
+	}
+
+	// Run the cutflows, in a single event loop.
+	res, err := ana.RunAll()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Print the results
+	res.Print()
+
+	// Output:
+	// | Cut name      | Raw yields |   Abs. eff. (%) |   Rel. eff. (%) |    Weighted yields | Abs. eff. (%) | Rel. eff. (%) |
+	// |---------------|------------|-----------------|-----------------|--------------------|---------------|---------------|
+	// | Preselection  |      19621 | 100.0 +0.0/-0.0 | 100.0 +0.0/-0.0 | 107318.87 ± 932.22 |   100.0 ± 0.0 |   100.0 ± 0.0 |
+	// | Eta > 0.5     |       7052 |  35.9 +0.3/-0.3 |  35.9 +0.3/-0.3 |  38194.18 ± 551.14 |    35.6 ± 0.4 |    35.6 ± 0.4 |
+	// | Phi < 2.0 rad |       5765 |  29.4 +0.3/-0.3 |  81.7 +0.5/-0.5 |  31298.63 ± 499.20 |    29.2 ± 0.4 |    81.9 ± 0.6 |
+	//
+	// Channel electron:
+	//
+	// | Cut name      | Raw yields |   Abs. eff. (%) |   Rel. eff. (%) |    Weighted yields | Abs. eff. (%) | Rel. eff. (%) |
+	// |---------------|------------|-----------------|-----------------|--------------------|---------------|---------------|
+	// | Preselection  |      19621 | 100.0 +0.0/-0.0 | 100.0 +0.0/-0.0 | 107318.87 ± 932.22 |   100.0 ± 0.0 |   100.0 ± 0.0 |
+	// | Eta > 0.5     |       7052 |  35.9 +0.3/-0.3 |  35.9 +0.3/-0.3 |  38194.18 ± 551.14 |    35.6 ± 0.4 |    35.6 ± 0.4 |
+	// | Phi < 2.0 rad |       5765 |  29.4 +0.3/-0.3 |  81.7 +0.5/-0.5 |  31298.63 ± 499.20 |    29.2 ± 0.4 |    81.9 ± 0.6 |
+	// | Electron      |       2845 |  14.5 +0.3/-0.3 |  49.3 +0.7/-0.7 |  15226.73 ± 345.13 |    14.2 ± 0.3 |    48.6 ± 0.8 |
+	// | pT > 50 GeV   |       1212 |   6.2 +0.2/-0.2 |  42.6 +0.9/-0.9 |  10258.64 ± 319.61 |     9.6 ± 0.3 |    67.4 ± 0.9 |
+	//
+	// Channel muon:
+	//
+	// | Cut name      | Raw yields |   Abs. eff. (%) |   Rel. eff. (%) |    Weighted yields | Abs. eff. (%) | Rel. eff. (%) |
+	// |---------------|------------|-----------------|-----------------|--------------------|---------------|---------------|
+	// | Preselection  |      19621 | 100.0 +0.0/-0.0 | 100.0 +0.0/-0.0 | 107318.87 ± 932.22 |   100.0 ± 0.0 |   100.0 ± 0.0 |
+	// | Eta > 0.5     |       7052 |  35.9 +0.3/-0.3 |  35.9 +0.3/-0.3 |  38194.18 ± 551.14 |    35.6 ± 0.4 |    35.6 ± 0.4 |
+	// | Phi < 2.0 rad |       5765 |  29.4 +0.3/-0.3 |  81.7 +0.5/-0.5 |  31298.63 ± 499.20 |    29.2 ± 0.4 |    81.9 ± 0.6 |
+	// | Muon          |       2920 |  14.9 +0.3/-0.3 |  50.7 +0.7/-0.7 |  16071.90 ± 360.67 |    15.0 ± 0.3 |    51.4 ± 0.8 |
+	// | pT > 50 GeV   |       1311 |   6.7 +0.2/-0.2 |  44.9 +0.9/-0.9 |  11242.02 ± 337.15 |    10.5 ± 0.3 |    69.9 ± 0.8 |
+	//
+	// N-1 efficiencies:
+	//
+	// | Cut name      | N-1 raw yields |       Eff. (%) | N-1 weighted yields |   Eff. (%) |
+	// |---------------|----------------|----------------|---------------------|------------|
+	// | Eta > 0.5     |          16064 | 35.9 +0.4/-0.4 |   87693.22 ± 841.76 | 35.7 ± 0.5 |
+	// | Phi < 2.0 rad |           7052 | 81.7 +0.5/-0.5 |   38194.18 ± 551.14 | 81.9 ± 0.6 |
+}
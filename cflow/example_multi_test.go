@@ -0,0 +1,214 @@
+package cflow_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/rmadar/tree-gonalyzer/cflow"
+)
+
+func ExampleMultiAnalysis() {
+
+	// Define the multi-sample cutflow analyzer
+	ana := cflow.MultiAnalysis{
+		NewEvent:     func() cflow.Evt { return &usrEvt{} },
+		Preselection: presel,
+		Cuts: []cflow.Cut{
+			{Name: "Electron channel", Sel: cut0},
+			{Name: "pT > 10 GeV", Sel: cut1},
+			{Name: "Phi < 2.0 rad", Sel: cut2},
+		},
+		Samples: []cflow.Sample{
+			{
+				Name:      "Background",
+				FilesName: []string{"../testdata/file2.root"},
+				TreeName:  "truth",
+				Xsec:      800,
+				Ngen:      1e6,
+			},
+			{
+				Name:      "Signal",
+				Signal:    true,
+				FilesName: []string{"../testdata/file3.root"},
+				TreeName:  "truth",
+				Xsec:      5,
+				Ngen:      1e5,
+				Weight:    func(e cflow.Evt) float64 { return 1 },
+			},
+		},
+		Lumi: 10,
+	}
+
+	// Run the cutflows of all samples
+	mcf, err := ana.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Print the result, or write it with WriteMarkdown,
+	// WriteLaTeX, WriteCSV and WriteJSON.
+	mcf.Print()
+
+	// Output:
+	// | Cut name         |          Background | Abs. eff. (%) | Rel. eff. (%) |          Signal | Abs. eff. (%) | Rel. eff. (%) |    S/B | S/sqrt(B) |
+	// |------------------|---------------------|---------------|---------------|-----------------|---------------|---------------|--------|-----------|
+	// | Preselection     | 208859.99 ± 3617.94 |   100.0 ± 0.0 |   100.0 ± 0.0 | 2495.50 ± 35.32 |   100.0 ± 0.0 |   100.0 ± 0.0 | 0.0119 |    5.4605 |
+	// | Electron channel |  73535.33 ± 2147.39 |    35.2 ± 0.8 |    35.2 ± 0.8 |  881.50 ± 20.99 |    35.3 ± 0.7 |    35.3 ± 0.7 | 0.0120 |    3.2507 |
+	// | pT > 10 GeV      |  73062.77 ± 2146.74 |    35.0 ± 0.8 |    99.4 ± 0.1 |  881.50 ± 20.99 |    35.3 ± 0.7 |   100.0 ± 0.0 | 0.0121 |    3.2612 |
+	// | Phi < 2.0 rad    |  59188.57 ± 1911.81 |    28.3 ± 0.8 |    81.0 ± 1.2 |  722.50 ± 19.01 |    29.0 ± 0.6 |    82.0 ± 0.9 | 0.0122 |    2.9697 |
+}
+
+func ExampleMultiAnalysis_error() {
+
+	ana := cflow.MultiAnalysis{
+		NewEvent: func() cflow.Evt { return &usrEvt{} },
+		Cuts:     []cflow.Cut{{Name: "Electron channel", Sel: cut0}},
+		Samples: []cflow.Sample{
+			{Name: "Signal", FilesName: []string{"../testdata/file2.root"}, TreeName: "truth"},
+			{Name: "Missing", FilesName: []string{"../testdata/missing.root"}, TreeName: "truth"},
+		},
+	}
+
+	_, err := ana.Run()
+	fmt.Println(err != nil)
+
+	// Output:
+	// true
+}
+
+func ExampleMultiAnalysis_RunAll() {
+
+	// Lepton flavour cut
+	flavour := func(pid int32) func(e cflow.Evt) bool {
+		return func(e cflow.Evt) bool { return e.(*usrEvt).pid == pid }
+	}
+
+	// Define the multi-sample cutflow analyzer, with a
+	// cut sequence forking into an electron and a muon
+	// channels, and the N-1 efficiencies.
+	ana := cflow.MultiAnalysis{
+		NewEvent: func() cflow.Evt { return &usrEvt{} },
+		Cuts: []cflow.Cut{
+			{Name: "Eta > 0.5", Sel: cut0},
+			{Name: "Phi < 2.0 rad", Sel: cut2},
+		},
+		Channels: []cflow.Channel{
+			{Name: "electron", Cuts: []cflow.Cut{{Name: "Electron", Sel: flavour(11)}}},
+			{Name: "muon", Cuts: []cflow.Cut{{Name: "Muon", Sel: flavour(13)}}},
+		},
+		NMinusOne: true,
+		Samples: []cflow.Sample{
+			{
+				Name:      "Background",
+				FilesName: []string{"../testdata/file2.root"},
+				TreeName:  "truth",
+				Xsec:      800,
+				Ngen:      1e6,
+			},
+			{
+				Name:      "Signal",
+				Signal:    true,
+				FilesName: []string{"../testdata/file3.root"},
+				TreeName:  "truth",
+				Xsec:      5,
+				Ngen:      1e5,
+				Weight:    func(e cflow.Evt) float64 { return 1 },
+			},
+		},
+		Lumi: 10,
+	}
+
+	// Run the cutflows of all samples
+	res, err := ana.RunAll()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Print the result
+	res.Print()
+
+	// Output:
+	// | Cut name      |          Background | Abs. eff. (%) | Rel. eff. (%) |          Signal | Abs. eff. (%) | Rel. eff. (%) |    S/B | S/sqrt(B) |
+	// |---------------|---------------------|---------------|---------------|-----------------|---------------|---------------|--------|-----------|
+	// | Eta > 0.5     | 150564.90 ± 3076.89 |   100.0 ± 0.0 |   100.0 ± 0.0 | 1772.50 ± 29.77 |   100.0 ± 0.0 |   100.0 ± 0.0 | 0.0118 |    4.5680 |
+	// | Phi < 2.0 rad | 123340.62 ± 2780.71 |    81.9 ± 0.8 |    81.9 ± 0.8 | 1452.00 ± 26.94 |    81.9 ± 0.6 |    81.9 ± 0.6 | 0.0118 |    4.1344 |
+	//
+	// Channel electron:
+	//
+	// | Cut name      |          Background | Abs. eff. (%) | Rel. eff. (%) |          Signal | Abs. eff. (%) | Rel. eff. (%) |    S/B | S/sqrt(B) |
+	// |---------------|---------------------|---------------|---------------|-----------------|---------------|---------------|--------|-----------|
+	// | Eta > 0.5     | 150564.90 ± 3076.89 |   100.0 ± 0.0 |   100.0 ± 0.0 | 1772.50 ± 29.77 |   100.0 ± 0.0 |   100.0 ± 0.0 | 0.0118 |    4.5680 |
+	// | Phi < 2.0 rad | 123340.62 ± 2780.71 |    81.9 ± 0.8 |    81.9 ± 0.8 | 1452.00 ± 26.94 |    81.9 ± 0.6 |    81.9 ± 0.6 | 0.0118 |    4.1344 |
+	// | Electron      |  59543.30 ± 1912.36 |    39.5 ± 1.0 |    48.3 ± 1.1 |  722.50 ± 19.01 |    40.8 ± 0.8 |    49.8 ± 0.9 | 0.0121 |    2.9609 |
+	//
+	// Channel muon:
+	//
+	// | Cut name      |          Background | Abs. eff. (%) | Rel. eff. (%) |          Signal | Abs. eff. (%) | Rel. eff. (%) |    S/B | S/sqrt(B) |
+	// |---------------|---------------------|---------------|---------------|-----------------|---------------|---------------|--------|-----------|
+	// | Eta > 0.5     | 150564.90 ± 3076.89 |   100.0 ± 0.0 |   100.0 ± 0.0 | 1772.50 ± 29.77 |   100.0 ± 0.0 |   100.0 ± 0.0 | 0.0118 |    4.5680 |
+	// | Phi < 2.0 rad | 123340.62 ± 2780.71 |    81.9 ± 0.8 |    81.9 ± 0.8 | 1452.00 ± 26.94 |    81.9 ± 0.6 |    81.9 ± 0.6 | 0.0118 |    4.1344 |
+	// | Muon          |  63797.32 ± 2018.73 |    42.4 ± 1.0 |    51.7 ± 1.1 |  729.50 ± 19.10 |    41.2 ± 0.8 |    50.2 ± 0.9 | 0.0114 |    2.8882 |
+	//
+	// N-1 efficiencies of Background:
+	//
+	// | Cut name      | N-1 raw yields |       Eff. (%) | N-1 weighted yields |   Eff. (%) |
+	// |---------------|----------------|----------------|---------------------|------------|
+	// | Eta > 0.5     |           8174 | 36.8 +0.5/-0.5 | 339703.99 ± 4619.77 | 36.3 ± 0.7 |
+	// | Phi < 2.0 rad |           3692 | 81.4 +0.6/-0.7 | 150564.90 ± 3076.89 | 81.9 ± 0.8 |
+	//
+	// N-1 efficiencies of Signal:
+	//
+	// | Cut name      | N-1 raw yields |       Eff. (%) | N-1 weighted yields |   Eff. (%) |
+	// |---------------|----------------|----------------|---------------------|------------|
+	// | Eta > 0.5     |           8188 | 35.5 +0.5/-0.5 |     4094.00 ± 45.24 | 35.5 ± 0.5 |
+	// | Phi < 2.0 rad |           3545 | 81.9 +0.7/-0.7 |     1772.50 ± 29.77 | 81.9 ± 0.6 |
+}
+
+func ExampleMultiAnalysis_data() {
+
+	// Data and simulated samples, only the latter being
+	// normalized to the luminosity.
+	ana := cflow.MultiAnalysis{
+		NewEvent: func() cflow.Evt { return &usrEvt{} },
+		Cuts: []cflow.Cut{
+			{Name: "Eta > 0.5", Sel: cut0},
+			{Name: "Phi < 2.0 rad", Sel: cut2},
+		},
+		Samples: []cflow.Sample{
+			{
+				Name:      "Data",
+				Data:      true,
+				FilesName: []string{"../testdata/file2.root"},
+				TreeName:  "truth",
+				Xsec:      800,
+				Ngen:      1e6,
+				Weight:    func(e cflow.Evt) float64 { return 1 },
+			},
+			{
+				Name:      "Background",
+				FilesName: []string{"../testdata/file2.root"},
+				TreeName:  "truth",
+				Xsec:      800,
+				Ngen:      1e6,
+				Weight:    func(e cflow.Evt) float64 { return 1 },
+			},
+		},
+		Lumi: 10,
+	}
+
+	mcf, err := ana.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Data yields are the raw ones, the background ones
+	// being scaled by 10*1000*800/1e6 = 8.
+	for ic, cut := range mcf.Cuts {
+		data, bkg := mcf.Flows[0][ic], mcf.Flows[1][ic]
+		fmt.Printf("%-13s data: %.0f (raw %.0f), bkg: %.0f (raw %.0f)\n", cut, data.Wgt, data.Raw, bkg.Wgt, bkg.Raw)
+	}
+
+	// Output:
+	// Eta > 0.5     data: 3692 (raw 3692), bkg: 29536 (raw 3692)
+	// Phi < 2.0 rad data: 3005 (raw 3005), bkg: 24040 (raw 3005)
+}
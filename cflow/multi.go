@@ -0,0 +1,396 @@
+package cflow
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+
+	"github.com/rmadar/tree-gonalyzer/internal/table"
+)
+
+// Sample type groups together the inputs of
+// a sample in a multi-sample cut flow, and its
+// normalization.
+type Sample struct {
+
+	// Name of the sample.
+	Name string
+
+	// Signal sample, rather than background.
+	Signal bool
+
+	// Data sample, neither signal nor background,
+	// and not normalized.
+	Data bool
+
+	// List of the name of files to be analyzed.
+	FilesName []string
+
+	// Name of the TTree to be analyzed.
+	TreeName string
+
+	// Cross-section in pb, and number of generated
+	// events. They are taken as 1 if zero.
+	Xsec float64
+	Ngen float64
+
+	// Event weight of the sample, instead of
+	// Evt.Weight() if not nil.
+	Weight func(e Evt) float64
+}
+
+// MultiAnalysis type groups together all needed
+// information to perform a cutflow analysis on
+// several samples, processed concurrently.
+type MultiAnalysis struct {
+
+	// NewEvent returns a new event model, implementing
+	// the Evt interface. One event model is created per
+	// sample, since samples are read concurrently.
+	NewEvent func() Evt
+
+	// Selection applied before applying individual cuts
+	// of the cut sequence.
+	Preselection func(e Evt) bool
+
+	// Slice of cuts defining each stage of the cut flow.
+	// The cuts are cumulated: if an event passes n-th cut,
+	// it means it passes cut[0] && cut[1] && ... && cut[n].
+	Cuts []Cut
+
+	// Channels forking the cut flows after the cut sequence,
+	// as in Analysis.
+	Channels []Channel
+
+	// NMinusOne enables the N-1 efficiencies of the cuts
+	// of each sample, as in Analysis.
+	NMinusOne bool
+
+	// List of samples to be analyzed.
+	Samples []Sample
+
+	// Integrated luminosity in 1/fb. The weighted yields
+	// of a sample are normalized by Lumi*1000*Xsec/Ngen,
+	// unless Lumi is zero or the sample is data.
+	Lumi float64
+}
+
+// MultiCutFlow contains the cut flows of several samples,
+// with the total signal and background yields and the
+// S/B and S/sqrt(B) ratios of each cut stage. They are
+// zero if the total background is zero.
+type MultiCutFlow struct {
+	Cuts       []string  `json:"cuts"`          // Name of the cut stages.
+	Samples    []string  `json:"samples"`       // Name of the samples.
+	Flows      []CutFlow `json:"flows"`         // Normalized cut flows Flows[iSample].
+	Signal     []float64 `json:"signal"`        // Total signal weighted yields.
+	Background []float64 `json:"background"`    // Total background weighted yields.
+	SOverB     []float64 `json:"s_over_b"`      // Signal over background ratios.
+	SOverSqrtB []float64 `json:"s_over_sqrt_b"` // Signal over square root of background.
+}
+
+// MultiResult contains the cut flows of several samples,
+// the cut flows of their channels and their N-1 efficiencies.
+type MultiResult struct {
+
+	// Cut flows of the cut sequence, starting with the
+	// preselection, if any.
+	CutFlow MultiCutFlow `json:"cut_flow"`
+
+	// Cut flows of the channels, made of the stages of
+	// the common cut flows followed by those of each channel,
+	// with their own S/B and S/sqrt(B).
+	Channels []MultiChannelFlow `json:"channels,omitempty"`
+
+	// N-1 efficiencies of the cut sequence of each sample,
+	// NMinusOne[iSample], nil if not enabled.
+	NMinusOne []NMinusOneTable `json:"n_minus_one,omitempty"`
+}
+
+// MultiChannelFlow is the cut flows of several samples
+// in a channel.
+type MultiChannelFlow struct {
+	Name    string       `json:"name"`
+	CutFlow MultiCutFlow `json:"cut_flow"`
+}
+
+// Run executes the event loops of all samples concurrently,
+// and returns their cut flows, normalized to the luminosity,
+// with S/B and S/sqrt(B). An error is returned if a sample
+// cannot be read.
+func (ana *MultiAnalysis) Run() (MultiCutFlow, error) {
+	res, err := ana.RunAll()
+	return res.CutFlow, err
+}
+
+// RunAll executes the event loops as Run does, and returns
+// the cut flows of the channels and the N-1 efficiencies of
+// each sample together with the cut flows, all normalized to
+// the luminosity.
+func (ana *MultiAnalysis) RunAll() (MultiResult, error) {
+
+	if ana.NewEvent == nil {
+		return MultiResult{}, fmt.Errorf("cflow: no event model")
+	}
+
+	// Run all samples concurrently
+	results := make([]Result, len(ana.Samples))
+	errs := make([]error, len(ana.Samples))
+	var wg sync.WaitGroup
+	wg.Add(len(ana.Samples))
+	for i := range ana.Samples {
+		go func(i int) {
+			defer wg.Done()
+			s := ana.Samples[i]
+			a := Analysis{
+				Preselection: ana.Preselection,
+				Cuts:         ana.Cuts,
+				Channels:     ana.Channels,
+				NMinusOne:    ana.NMinusOne,
+				FilesName:    s.FilesName,
+				TreeName:     s.TreeName,
+			}
+			results[i], errs[i] = a.run(ana.NewEvent(), s.Weight)
+		}(i)
+	}
+	wg.Wait()
+
+	// Report the first error, following the sample order.
+	for i, err := range errs {
+		if err != nil {
+			return MultiResult{}, fmt.Errorf("cflow: sample %q: %w", ana.Samples[i].Name, err)
+		}
+	}
+
+	// Normalize the weighted yields of the simulated
+	// samples, and gather the cut flows of all samples.
+	flows := make([]CutFlow, len(ana.Samples))
+	for i, s := range ana.Samples {
+		if !s.Data {
+			results[i].scale(s.norm(ana.Lumi))
+		}
+		flows[i] = results[i].CutFlow
+	}
+	mres := MultiResult{CutFlow: NewMultiCutFlow(ana.Samples, flows)}
+	for ich, ch := range ana.Channels {
+		chFlows := make([]CutFlow, len(ana.Samples))
+		for i := range ana.Samples {
+			chFlows[i] = results[i].Channels[ich].CutFlow
+		}
+		mres.Channels = append(mres.Channels, MultiChannelFlow{
+			Name:    ch.Name,
+			CutFlow: NewMultiCutFlow(ana.Samples, chFlows),
+		})
+	}
+	if ana.NMinusOne {
+		mres.NMinusOne = make([]NMinusOneTable, len(ana.Samples))
+		for i := range ana.Samples {
+			mres.NMinusOne[i] = results[i].NMinusOne
+		}
+	}
+
+	return mres, nil
+}
+
+// scale multiplies the weighted yields of the result by
+// norm, leaving the efficiencies unchanged.
+func (res *Result) scale(norm float64) {
+	scale := func(wgt, wgt2 *float64) {
+		*wgt *= norm
+		*wgt2 *= norm * norm
+	}
+	for ic := range res.CutFlow {
+		scale(&res.CutFlow[ic].Wgt, &res.CutFlow[ic].Wgt2)
+	}
+	for _, ch := range res.Channels {
+		for ic := range ch.CutFlow {
+			scale(&ch.CutFlow[ic].Wgt, &ch.CutFlow[ic].Wgt2)
+		}
+	}
+	for ic := range res.NMinusOne {
+		scale(&res.NMinusOne[ic].Wgt, &res.NMinusOne[ic].Wgt2)
+	}
+}
+
+// NewMultiCutFlow gathers the cut flows of samples, with the
+// same cut stages, and computes the total signal and background
+// yields, with S/B and S/sqrt(B). Only the name and the type of
+// the samples are used: the cut flows are already normalized.
+func NewMultiCutFlow(samples []Sample, flows []CutFlow) MultiCutFlow {
+
+	var stages CutFlow
+	if len(flows) > 0 {
+		stages = flows[0]
+	}
+	mcf := MultiCutFlow{
+		Cuts:       make([]string, len(stages)),
+		Samples:    make([]string, len(samples)),
+		Flows:      flows,
+		Signal:     make([]float64, len(stages)),
+		Background: make([]float64, len(stages)),
+		SOverB:     make([]float64, len(stages)),
+		SOverSqrtB: make([]float64, len(stages)),
+	}
+	for ic, y := range stages {
+		mcf.Cuts[ic] = y.Name
+	}
+	for i, s := range samples {
+		mcf.Samples[i] = s.Name
+		for ic, y := range flows[i] {
+			switch {
+			case s.Data:
+			case s.Signal:
+				mcf.Signal[ic] += y.Wgt
+			default:
+				mcf.Background[ic] += y.Wgt
+			}
+		}
+	}
+	for ic := range stages {
+		if b := mcf.Background[ic]; b > 0 {
+			mcf.SOverB[ic] = mcf.Signal[ic] / b
+			mcf.SOverSqrtB[ic] = mcf.Signal[ic] / math.Sqrt(b)
+		}
+	}
+
+	return mcf
+}
+
+// norm returns the normalization factor of the sample.
+func (s Sample) norm(lumi float64) float64 {
+	if lumi == 0 {
+		return 1
+	}
+	xsec, ngen := s.Xsec, s.Ngen
+	if xsec == 0 {
+		xsec = 1
+	}
+	if ngen == 0 {
+		ngen = 1
+	}
+	return lumi * 1000 * xsec / ngen
+}
+
+// Print outputs nicely the result
+func (mcf MultiCutFlow) Print() {
+	mcf.WriteASCII(os.Stdout)
+}
+
+// table returns the side-by-side table of the cut flows, with
+// one row per cut stage, and efficiencies in percent.
+func (mcf MultiCutFlow) table() table.Table {
+	header := []string{"Cut name"}
+	for _, s := range mcf.Samples {
+		header = append(header, s, "Abs. eff. (%)", "Rel. eff. (%)")
+	}
+	header = append(header, "S/B", "S/sqrt(B)")
+	rows := make([]table.Row, len(mcf.Cuts))
+	for ic, cut := range mcf.Cuts {
+		row := []table.Cell{table.Text(cut)}
+		for _, cf := range mcf.Flows {
+			y := cf[ic]
+			row = append(row,
+				table.Sym(y.Wgt, y.WgtErr(), 2),
+				table.Sym(y.AbsEff.Wgt*100, y.AbsEff.WgtErr*100, 1),
+				table.Sym(y.RelEff.Wgt*100, y.RelEff.WgtErr*100, 1),
+			)
+		}
+		row = append(row, table.Value(mcf.SOverB[ic], 4), table.Value(mcf.SOverSqrtB[ic], 4))
+		rows[ic].Cells = row
+	}
+	return table.Table{Header: header, Groups: [][]table.Row{rows}}
+}
+
+// WriteASCII writes the cut flows side by side as an ASCII
+// table, with the weighted yields of each sample, and their
+// absolute and relative efficiencies, eg:
+//
+//	| Cut name         |         Background | Abs. eff. (%) | Rel. eff. (%) |         Signal | Abs. eff. (%) | Rel. eff. (%) |    S/B | S/sqrt(B) |
+//	|------------------|--------------------|---------------|---------------|----------------|---------------|---------------|--------|-----------|
+//	| Electron channel | 73535.33 ± 2147.39 |   100.0 ± 0.0 |   100.0 ± 0.0 | 881.50 ± 20.99 |   100.0 ± 0.0 |   100.0 ± 0.0 | 0.0120 |    3.2507 |
+//	| Phi < 2.0 rad    | 59188.57 ± 1911.81 |    80.5 ± 1.2 |    81.0 ± 1.2 | 722.50 ± 19.01 |    82.0 ± 0.9 |    82.0 ± 0.9 | 0.0122 |    2.9697 |
+func (mcf MultiCutFlow) WriteASCII(w io.Writer) error {
+	return mcf.table().WriteASCII(w)
+}
+
+// WriteMarkdown writes the cut flows side by side as a
+// Markdown table, with one row per cut stage.
+func (mcf MultiCutFlow) WriteMarkdown(w io.Writer) error {
+	return mcf.table().WriteMarkdown(w)
+}
+
+// WriteLaTeX writes the cut flows side by side as a LaTeX
+// tabular, with one row per cut stage.
+func (mcf MultiCutFlow) WriteLaTeX(w io.Writer) error {
+	return mcf.table().WriteLaTeX(w)
+}
+
+// WriteCSV writes the cut flows side by side as CSV, with
+// one record per cut stage: the raw and weighted yields of
+// each sample, with the efficiencies of the weighted yields
+// as fractions and their uncertainties, followed by S/B and
+// S/sqrt(B).
+func (mcf MultiCutFlow) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"cut"}
+	for _, s := range mcf.Samples {
+		header = append(header,
+			s+"_raw", s+"_wgt", s+"_wgt_err",
+			s+"_abs_eff", s+"_abs_eff_err", s+"_rel_eff", s+"_rel_eff_err",
+		)
+	}
+	cw.Write(append(header, "s_over_b", "s_over_sqrt_b"))
+	for ic, cut := range mcf.Cuts {
+		rec := []string{cut}
+		for _, cf := range mcf.Flows {
+			y := cf[ic]
+			rec = append(rec,
+				f64(y.Raw), f64(y.Wgt), f64(y.WgtErr()),
+				f64(y.AbsEff.Wgt), f64(y.AbsEff.WgtErr), f64(y.RelEff.Wgt), f64(y.RelEff.WgtErr),
+			)
+		}
+		cw.Write(append(rec, f64(mcf.SOverB[ic]), f64(mcf.SOverSqrtB[ic])))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the cut flows as an indented JSON object.
+func (mcf MultiCutFlow) WriteJSON(w io.Writer) error {
+	return writeJSON(w, mcf)
+}
+
+// Print outputs nicely the result
+func (res MultiResult) Print() {
+	res.WriteASCII(os.Stdout)
+}
+
+// WriteASCII writes the cut flows, the cut flows of the
+// channels and the N-1 efficiencies of each sample as
+// ASCII tables.
+func (res MultiResult) WriteASCII(w io.Writer) error {
+	if err := res.CutFlow.WriteASCII(w); err != nil {
+		return err
+	}
+	for _, ch := range res.Channels {
+		fmt.Fprintf(w, "Channel %s:\n", ch.Name)
+		if err := ch.CutFlow.WriteASCII(w); err != nil {
+			return err
+		}
+	}
+	for i, t := range res.NMinusOne {
+		fmt.Fprintf(w, "N-1 efficiencies of %s:\n", res.CutFlow.Samples[i])
+		if err := t.WriteASCII(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the result as an indented JSON object.
+func (res MultiResult) WriteJSON(w io.Writer) error {
+	return writeJSON(w, res)
+}
@@ -0,0 +1,146 @@
+package cflow
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rmadar/tree-gonalyzer/internal/table"
+)
+
+// NMinusOne contains the yields of the events passing
+// all the cuts but one, and the efficiency of this cut
+// for these events.
+type NMinusOne struct {
+	Name string  `json:"name"` // Name of the cut.
+	Raw  float64 `json:"raw"`  // Raw yields passing all other cuts.
+	Wgt  float64 `json:"wgt"`  // Weighted yields passing all other cuts.
+	Wgt2 float64 `json:"wgt2"` // Sum of squared weights.
+	Eff  Eff     `json:"eff"`  // Efficiency of the cut.
+}
+
+// NMinusOneTable contains the N-1 efficiencies of
+// a cut sequence, once per cut.
+type NMinusOneTable []NMinusOne
+
+// newNMinusOneTable returns the N-1 efficiencies of the
+// cuts, from the yields passing all cuts and those passing
+// all the other cuts.
+func newNMinusOneTable(all Yields, cuts []Cut, others []Yields) NMinusOneTable {
+	t := make(NMinusOneTable, len(cuts))
+	for i, cut := range cuts {
+		y := others[i]
+		t[i] = NMinusOne{
+			Name: cut.Name,
+			Raw:  y.Raw,
+			Wgt:  y.Wgt,
+			Wgt2: y.Wgt2,
+			Eff:  efficiency(all, y),
+		}
+	}
+	return t
+}
+
+// Print outputs nicely the result
+func (t NMinusOneTable) Print() {
+	t.WriteASCII(os.Stdout)
+}
+
+// table returns the table of the N-1 efficiencies, with
+// one row per cut and efficiencies in percent.
+func (t NMinusOneTable) table() table.Table {
+	rows := make([]table.Row, len(t))
+	for i, y := range t {
+		rows[i].Cells = []table.Cell{
+			table.Text(y.Name),
+			table.Value(y.Raw, 0),
+			table.Asym(y.Eff.Raw*100, y.Eff.RawErrLo*100, y.Eff.RawErrUp*100, 1),
+			table.Sym(y.Wgt, (Yields{Wgt2: y.Wgt2}).WgtErr(), 2),
+			table.Sym(y.Eff.Wgt*100, y.Eff.WgtErr*100, 1),
+		}
+	}
+	return table.Table{Header: []string{
+		"Cut name",
+		"N-1 raw yields", "Eff. (%)",
+		"N-1 weighted yields", "Eff. (%)",
+	}, Groups: [][]table.Row{rows}}
+}
+
+// WriteASCII writes the N-1 efficiencies as an ASCII table,
+// with the yields passing all the other cuts, eg:
+//
+//	| Cut name      | N-1 raw yields |       Eff. (%) | N-1 weighted yields |   Eff. (%) |
+//	|---------------|----------------|----------------|---------------------|------------|
+//	| Eta > 0.5     |          16064 | 35.9 +0.4/-0.4 |   87693.22 ± 841.76 | 35.7 ± 0.5 |
+//	| Phi < 2.0 rad |           7052 | 81.7 +0.5/-0.5 |   38194.18 ± 551.14 | 81.9 ± 0.6 |
+func (t NMinusOneTable) WriteASCII(w io.Writer) error {
+	return t.table().WriteASCII(w)
+}
+
+// WriteMarkdown writes the N-1 efficiencies as a Markdown
+// table, with one row per cut.
+func (t NMinusOneTable) WriteMarkdown(w io.Writer) error {
+	return t.table().WriteMarkdown(w)
+}
+
+// WriteLaTeX writes the N-1 efficiencies as a LaTeX tabular,
+// with one row per cut.
+func (t NMinusOneTable) WriteLaTeX(w io.Writer) error {
+	return t.table().WriteLaTeX(w)
+}
+
+// WriteCSV writes the N-1 efficiencies as CSV, with one
+// record per cut and efficiencies as fractions.
+func (t NMinusOneTable) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{
+		"cut",
+		"raw", "raw_eff", "raw_eff_err_lo", "raw_eff_err_up",
+		"wgt", "wgt_err", "wgt_eff", "wgt_eff_err",
+	})
+	for _, y := range t {
+		cw.Write([]string{
+			y.Name,
+			f64(y.Raw), f64(y.Eff.Raw), f64(y.Eff.RawErrLo), f64(y.Eff.RawErrUp),
+			f64(y.Wgt), f64((Yields{Wgt2: y.Wgt2}).WgtErr()), f64(y.Eff.Wgt), f64(y.Eff.WgtErr),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the N-1 efficiencies as an indented
+// JSON array, with one object per cut.
+func (t NMinusOneTable) WriteJSON(w io.Writer) error {
+	return writeJSON(w, t)
+}
+
+// Print outputs nicely the result
+func (res Result) Print() {
+	res.WriteASCII(os.Stdout)
+}
+
+// WriteASCII writes the cut flow, the cut flows of the
+// channels and the N-1 efficiencies as ASCII tables.
+func (res Result) WriteASCII(w io.Writer) error {
+	if err := res.CutFlow.WriteASCII(w); err != nil {
+		return err
+	}
+	for _, ch := range res.Channels {
+		fmt.Fprintf(w, "Channel %s:\n", ch.Name)
+		if err := ch.CutFlow.WriteASCII(w); err != nil {
+			return err
+		}
+	}
+	if res.NMinusOne != nil {
+		fmt.Fprintf(w, "N-1 efficiencies:\n")
+		return res.NMinusOne.WriteASCII(w)
+	}
+	return nil
+}
+
+// WriteJSON writes the result as an indented JSON object.
+func (res Result) WriteJSON(w io.Writer) error {
+	return writeJSON(w, res)
+}
@@ -0,0 +1,94 @@
+// Command gonalyzer runs an analysis described by a YAML or JSON
+// configuration file (see ana.NewFromConfig):
+//
+//	gonalyzer run [options] analysis.yaml
+//
+// Command line options override the settings of the file. Functions
+// referred by name in the file must be registered with
+// ana.RegisterTreeFunc: to use them, build a copy of this command
+// importing the package which registers them.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rmadar/tree-gonalyzer/ana"
+)
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "run" {
+		usage()
+		os.Exit(2)
+	}
+	if err := run(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "gonalyzer: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: gonalyzer run [options] analysis.yaml\n")
+}
+
+// Run the analysis of the configuration file given in args.
+func run(args []string) error {
+
+	// Options passed by command lines.
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	fs.Usage = func() {
+		usage()
+		fs.PrintDefaults()
+	}
+	var (
+		nMax     = fs.Int64("nevts", -1, "Maximum number of processed event per sample component.")
+		pFormat  = fs.String("f", "png", "Select output plot format")
+		savePath = fs.String("o", "outputs", "Select output directory")
+		nWorkers = fs.Int("workers", 0, "Number of workers over entry ranges")
+		doCache  = fs.Bool("cache", false, "Cache the histograms of each component")
+	)
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	// Only the options explicitly given override the file.
+	var opts []ana.Options
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "nevts":
+			opts = append(opts, ana.WithNevtsMax(*nMax))
+		case "f":
+			opts = append(opts, ana.WithSaveFormat(*pFormat))
+		case "o":
+			opts = append(opts, ana.WithSavePath(*savePath))
+		case "workers":
+			opts = append(opts, ana.WithNWorkers(*nWorkers))
+		case "cache":
+			opts = append(opts, ana.WithCache(*doCache))
+		}
+	})
+
+	analyzer, err := ana.NewFromConfig(fs.Arg(0), opts...)
+	if err != nil {
+		return err
+	}
+
+	// Stop the event loops properly on SIGINT/SIGTERM
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		cancel()
+	}()
+
+	// Run the analyzer and produce all plots
+	return analyzer.RunContext(ctx)
+}
@@ -15,13 +15,18 @@
 //  }
 //
 //  // Create analyzer object with some options
-//  analyzer := ana.New(samples, variables)
+//  analyzer, err := ana.New(samples, variables)
+//  if err != nil {
+//    log.Fatal(err)
+//  }
 //
 //  // Produce plots
-//  analyzer.Run()
+//  if err := analyzer.Run(); err != nil {
+//    log.Fatal(err)
+//  }
 //
 // There is also the possibility of doing cutflows in a simple way. Few lines of code
-// can produce this ASCII table:
+// can produce this ASCII table, also available in Markdown, LaTeX, CSV and JSON:
 //
 //  | Cut name              | Raw Yields                 | Weighted Yields            |
 //  |                       |                 Abs    Rel |                 Abs    Rel |
@@ -8,6 +8,7 @@ require (
 	golang.org/x/exp v0.0.0-20200513190911-00229845015e
 	gonum.org/v1/gonum v0.8.1
 	gonum.org/v1/plot v0.8.1
+	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776
 )
 
 // replace github.com/rmadar/hplot-style => /home/rmadar/cernbox/goDev/hplot-style
@@ -0,0 +1,26 @@
+// Package fastfuncs provides the binding functions of the fast
+// formulas of the TreeFunc signatures. The default ones are
+// generated in funcs_gen.go, the others in package ana/allfuncs,
+// which registers them when it is imported.
+package fastfuncs
+
+import "reflect"
+
+// Func is the prototype of a function type, with the function
+// returning a function of this type closing on the pointers to
+// its arguments.
+type Func struct {
+	Fct  interface{}
+	Bind func(fct interface{}, ptrs []interface{}) interface{}
+}
+
+// Funcs maps the registered function types to their Func.
+var Funcs = make(map[reflect.Type]Func)
+
+// Register adds the function types of fs to Funcs. It is
+// only called from init(), and is therefore not locked.
+func Register(fs []Func) {
+	for _, f := range fs {
+		Funcs[reflect.TypeOf(f.Fct)] = f
+	}
+}
@@ -0,0 +1,119 @@
+// Package fastfuncstest checks the fast formulas of the TreeFunc
+// signatures against the generic rfunc.Formula, in tests.
+package fastfuncstest
+
+import (
+	"fmt"
+	"reflect"
+
+	"go-hep.org/x/hep/groot/rtree/rfunc"
+)
+
+// Bind returns the function of the fast formula of fct, reading
+// the branches rvars, bound to the pointers ptrs.
+type Bind func(rvars []string, fct interface{}, ptrs []interface{}) (interface{}, error)
+
+// Check returns an error if the function bound by bind, for a
+// function of type typ, doesn't give the same results as the
+// generic formula, for several values of its arguments.
+func Check(typ reflect.Type, bind Bind) error {
+
+	fct := sum(typ)
+	names := make([]string, typ.NumIn())
+	ptrs := make([]interface{}, typ.NumIn())
+	vals := make([]reflect.Value, typ.NumIn())
+	for i := range ptrs {
+		names[i] = string(rune('a' + i))
+		vals[i] = reflect.New(typ.In(i))
+		ptrs[i] = vals[i].Interface()
+	}
+
+	fast, err := bind(names, fct, ptrs)
+	if err != nil {
+		return fmt.Errorf("%v: could not bind fast formula: %w", typ, err)
+	}
+	generic, err := rfunc.NewGenericFormula(names, fct)
+	if err != nil {
+		return fmt.Errorf("%v: could not create generic formula: %w", typ, err)
+	}
+	if err := generic.Bind(ptrs); err != nil {
+		return fmt.Errorf("%v: could not bind generic formula: %w", typ, err)
+	}
+	if got, want := reflect.TypeOf(fast), reflect.TypeOf(generic.Func()); got != want {
+		return fmt.Errorf("%v: invalid function type: got=%v, want=%v", typ, got, want)
+	}
+
+	for evt := 0; evt < 3; evt++ {
+		for i, v := range vals {
+			setValue(v.Elem(), evt+i)
+		}
+		got := reflect.ValueOf(fast).Call(nil)[0].Interface()
+		want := reflect.ValueOf(generic.Func()).Call(nil)[0].Interface()
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("%v: event %d: got=%v, want=%v", typ, evt, got, want)
+		}
+	}
+	return nil
+}
+
+// Helper function returning a function of type typ, returning
+// the sum of its arguments (with the length of slices), as bool,
+// float64 or []float64.
+func sum(typ reflect.Type) interface{} {
+	return reflect.MakeFunc(typ, func(args []reflect.Value) []reflect.Value {
+		s, n := 0.0, 0.0
+		for _, a := range args {
+			if a.Kind() != reflect.Slice {
+				s += toF64(a)
+				continue
+			}
+			n += float64(a.Len())
+			for i := 0; i < a.Len(); i++ {
+				s += toF64(a.Index(i))
+			}
+		}
+		out := reflect.New(typ.Out(0)).Elem()
+		switch v := out.Addr().Interface().(type) {
+		case *bool:
+			*v = s > 2
+		case *float64:
+			*v = s
+		case *[]float64:
+			*v = []float64{s, n}
+		}
+		return []reflect.Value{out}
+	}).Interface()
+}
+
+// Helper function converting a scalar value to float64.
+func toF64(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+		return 0
+	case reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	default:
+		return v.Float()
+	}
+}
+
+// Helper function setting a scalar or a slice value from n.
+func setValue(v reflect.Value, n int) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(n%2 == 1)
+	case reflect.Int32, reflect.Int64:
+		v.SetInt(int64(n))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(n) + 0.5)
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			setValue(s.Index(i), n+i)
+		}
+		v.Set(s)
+	}
+}
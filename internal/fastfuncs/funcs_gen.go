@@ -0,0 +1,3206 @@
+// Code generated by gen-funcs; DO NOT EDIT.
+
+package fastfuncs
+
+func init() {
+	Register([]Func{
+		{Fct: (func() bool)(nil), Bind: bindToBool},
+		{Fct: (func() float64)(nil), Bind: bindToF64},
+		{Fct: (func() []float64)(nil), Bind: bindToF64s},
+		{Fct: (func(bool) bool)(nil), Bind: bindBoolToBool},
+		{Fct: (func(bool) float64)(nil), Bind: bindBoolToF64},
+		{Fct: (func(bool) []float64)(nil), Bind: bindBoolToF64s},
+		{Fct: (func(int32) bool)(nil), Bind: bindI32ToBool},
+		{Fct: (func(int32) float64)(nil), Bind: bindI32ToF64},
+		{Fct: (func(int32) []float64)(nil), Bind: bindI32ToF64s},
+		{Fct: (func(int64) bool)(nil), Bind: bindI64ToBool},
+		{Fct: (func(int64) float64)(nil), Bind: bindI64ToF64},
+		{Fct: (func(int64) []float64)(nil), Bind: bindI64ToF64s},
+		{Fct: (func(float32) bool)(nil), Bind: bindF32ToBool},
+		{Fct: (func(float32) float64)(nil), Bind: bindF32ToF64},
+		{Fct: (func(float32) []float64)(nil), Bind: bindF32ToF64s},
+		{Fct: (func(float64) bool)(nil), Bind: bindF64ToBool},
+		{Fct: (func(float64) float64)(nil), Bind: bindF64ToF64},
+		{Fct: (func(float64) []float64)(nil), Bind: bindF64ToF64s},
+		{Fct: (func([]bool) bool)(nil), Bind: bindBoolsToBool},
+		{Fct: (func([]bool) float64)(nil), Bind: bindBoolsToF64},
+		{Fct: (func([]bool) []float64)(nil), Bind: bindBoolsToF64s},
+		{Fct: (func([]int32) bool)(nil), Bind: bindI32sToBool},
+		{Fct: (func([]int32) float64)(nil), Bind: bindI32sToF64},
+		{Fct: (func([]int32) []float64)(nil), Bind: bindI32sToF64s},
+		{Fct: (func([]int64) bool)(nil), Bind: bindI64sToBool},
+		{Fct: (func([]int64) float64)(nil), Bind: bindI64sToF64},
+		{Fct: (func([]int64) []float64)(nil), Bind: bindI64sToF64s},
+		{Fct: (func([]float32) bool)(nil), Bind: bindF32sToBool},
+		{Fct: (func([]float32) float64)(nil), Bind: bindF32sToF64},
+		{Fct: (func([]float32) []float64)(nil), Bind: bindF32sToF64s},
+		{Fct: (func([]float64) bool)(nil), Bind: bindF64sToBool},
+		{Fct: (func([]float64) float64)(nil), Bind: bindF64sToF64},
+		{Fct: (func([]float64) []float64)(nil), Bind: bindF64sToF64s},
+		{Fct: (func(bool, bool) bool)(nil), Bind: bindBoolBoolToBool},
+		{Fct: (func(bool, bool) float64)(nil), Bind: bindBoolBoolToF64},
+		{Fct: (func(bool, bool) []float64)(nil), Bind: bindBoolBoolToF64s},
+		{Fct: (func(bool, int32) bool)(nil), Bind: bindBoolI32ToBool},
+		{Fct: (func(bool, int32) float64)(nil), Bind: bindBoolI32ToF64},
+		{Fct: (func(bool, int32) []float64)(nil), Bind: bindBoolI32ToF64s},
+		{Fct: (func(bool, int64) bool)(nil), Bind: bindBoolI64ToBool},
+		{Fct: (func(bool, int64) float64)(nil), Bind: bindBoolI64ToF64},
+		{Fct: (func(bool, int64) []float64)(nil), Bind: bindBoolI64ToF64s},
+		{Fct: (func(bool, float32) bool)(nil), Bind: bindBoolF32ToBool},
+		{Fct: (func(bool, float32) float64)(nil), Bind: bindBoolF32ToF64},
+		{Fct: (func(bool, float32) []float64)(nil), Bind: bindBoolF32ToF64s},
+		{Fct: (func(bool, float64) bool)(nil), Bind: bindBoolF64ToBool},
+		{Fct: (func(bool, float64) float64)(nil), Bind: bindBoolF64ToF64},
+		{Fct: (func(bool, float64) []float64)(nil), Bind: bindBoolF64ToF64s},
+		{Fct: (func(bool, []bool) bool)(nil), Bind: bindBoolBoolsToBool},
+		{Fct: (func(bool, []bool) float64)(nil), Bind: bindBoolBoolsToF64},
+		{Fct: (func(bool, []bool) []float64)(nil), Bind: bindBoolBoolsToF64s},
+		{Fct: (func(bool, []int32) bool)(nil), Bind: bindBoolI32sToBool},
+		{Fct: (func(bool, []int32) float64)(nil), Bind: bindBoolI32sToF64},
+		{Fct: (func(bool, []int32) []float64)(nil), Bind: bindBoolI32sToF64s},
+		{Fct: (func(bool, []int64) bool)(nil), Bind: bindBoolI64sToBool},
+		{Fct: (func(bool, []int64) float64)(nil), Bind: bindBoolI64sToF64},
+		{Fct: (func(bool, []int64) []float64)(nil), Bind: bindBoolI64sToF64s},
+		{Fct: (func(bool, []float32) bool)(nil), Bind: bindBoolF32sToBool},
+		{Fct: (func(bool, []float32) float64)(nil), Bind: bindBoolF32sToF64},
+		{Fct: (func(bool, []float32) []float64)(nil), Bind: bindBoolF32sToF64s},
+		{Fct: (func(bool, []float64) bool)(nil), Bind: bindBoolF64sToBool},
+		{Fct: (func(bool, []float64) float64)(nil), Bind: bindBoolF64sToF64},
+		{Fct: (func(bool, []float64) []float64)(nil), Bind: bindBoolF64sToF64s},
+		{Fct: (func(int32, bool) bool)(nil), Bind: bindI32BoolToBool},
+		{Fct: (func(int32, bool) float64)(nil), Bind: bindI32BoolToF64},
+		{Fct: (func(int32, bool) []float64)(nil), Bind: bindI32BoolToF64s},
+		{Fct: (func(int32, int32) bool)(nil), Bind: bindI32I32ToBool},
+		{Fct: (func(int32, int32) float64)(nil), Bind: bindI32I32ToF64},
+		{Fct: (func(int32, int32) []float64)(nil), Bind: bindI32I32ToF64s},
+		{Fct: (func(int32, int64) bool)(nil), Bind: bindI32I64ToBool},
+		{Fct: (func(int32, int64) float64)(nil), Bind: bindI32I64ToF64},
+		{Fct: (func(int32, int64) []float64)(nil), Bind: bindI32I64ToF64s},
+		{Fct: (func(int32, float32) bool)(nil), Bind: bindI32F32ToBool},
+		{Fct: (func(int32, float32) float64)(nil), Bind: bindI32F32ToF64},
+		{Fct: (func(int32, float32) []float64)(nil), Bind: bindI32F32ToF64s},
+		{Fct: (func(int32, float64) bool)(nil), Bind: bindI32F64ToBool},
+		{Fct: (func(int32, float64) float64)(nil), Bind: bindI32F64ToF64},
+		{Fct: (func(int32, float64) []float64)(nil), Bind: bindI32F64ToF64s},
+		{Fct: (func(int32, []bool) bool)(nil), Bind: bindI32BoolsToBool},
+		{Fct: (func(int32, []bool) float64)(nil), Bind: bindI32BoolsToF64},
+		{Fct: (func(int32, []bool) []float64)(nil), Bind: bindI32BoolsToF64s},
+		{Fct: (func(int32, []int32) bool)(nil), Bind: bindI32I32sToBool},
+		{Fct: (func(int32, []int32) float64)(nil), Bind: bindI32I32sToF64},
+		{Fct: (func(int32, []int32) []float64)(nil), Bind: bindI32I32sToF64s},
+		{Fct: (func(int32, []int64) bool)(nil), Bind: bindI32I64sToBool},
+		{Fct: (func(int32, []int64) float64)(nil), Bind: bindI32I64sToF64},
+		{Fct: (func(int32, []int64) []float64)(nil), Bind: bindI32I64sToF64s},
+		{Fct: (func(int32, []float32) bool)(nil), Bind: bindI32F32sToBool},
+		{Fct: (func(int32, []float32) float64)(nil), Bind: bindI32F32sToF64},
+		{Fct: (func(int32, []float32) []float64)(nil), Bind: bindI32F32sToF64s},
+		{Fct: (func(int32, []float64) bool)(nil), Bind: bindI32F64sToBool},
+		{Fct: (func(int32, []float64) float64)(nil), Bind: bindI32F64sToF64},
+		{Fct: (func(int32, []float64) []float64)(nil), Bind: bindI32F64sToF64s},
+		{Fct: (func(int64, bool) bool)(nil), Bind: bindI64BoolToBool},
+		{Fct: (func(int64, bool) float64)(nil), Bind: bindI64BoolToF64},
+		{Fct: (func(int64, bool) []float64)(nil), Bind: bindI64BoolToF64s},
+		{Fct: (func(int64, int32) bool)(nil), Bind: bindI64I32ToBool},
+		{Fct: (func(int64, int32) float64)(nil), Bind: bindI64I32ToF64},
+		{Fct: (func(int64, int32) []float64)(nil), Bind: bindI64I32ToF64s},
+		{Fct: (func(int64, int64) bool)(nil), Bind: bindI64I64ToBool},
+		{Fct: (func(int64, int64) float64)(nil), Bind: bindI64I64ToF64},
+		{Fct: (func(int64, int64) []float64)(nil), Bind: bindI64I64ToF64s},
+		{Fct: (func(int64, float32) bool)(nil), Bind: bindI64F32ToBool},
+		{Fct: (func(int64, float32) float64)(nil), Bind: bindI64F32ToF64},
+		{Fct: (func(int64, float32) []float64)(nil), Bind: bindI64F32ToF64s},
+		{Fct: (func(int64, float64) bool)(nil), Bind: bindI64F64ToBool},
+		{Fct: (func(int64, float64) float64)(nil), Bind: bindI64F64ToF64},
+		{Fct: (func(int64, float64) []float64)(nil), Bind: bindI64F64ToF64s},
+		{Fct: (func(int64, []bool) bool)(nil), Bind: bindI64BoolsToBool},
+		{Fct: (func(int64, []bool) float64)(nil), Bind: bindI64BoolsToF64},
+		{Fct: (func(int64, []bool) []float64)(nil), Bind: bindI64BoolsToF64s},
+		{Fct: (func(int64, []int32) bool)(nil), Bind: bindI64I32sToBool},
+		{Fct: (func(int64, []int32) float64)(nil), Bind: bindI64I32sToF64},
+		{Fct: (func(int64, []int32) []float64)(nil), Bind: bindI64I32sToF64s},
+		{Fct: (func(int64, []int64) bool)(nil), Bind: bindI64I64sToBool},
+		{Fct: (func(int64, []int64) float64)(nil), Bind: bindI64I64sToF64},
+		{Fct: (func(int64, []int64) []float64)(nil), Bind: bindI64I64sToF64s},
+		{Fct: (func(int64, []float32) bool)(nil), Bind: bindI64F32sToBool},
+		{Fct: (func(int64, []float32) float64)(nil), Bind: bindI64F32sToF64},
+		{Fct: (func(int64, []float32) []float64)(nil), Bind: bindI64F32sToF64s},
+		{Fct: (func(int64, []float64) bool)(nil), Bind: bindI64F64sToBool},
+		{Fct: (func(int64, []float64) float64)(nil), Bind: bindI64F64sToF64},
+		{Fct: (func(int64, []float64) []float64)(nil), Bind: bindI64F64sToF64s},
+		{Fct: (func(float32, bool) bool)(nil), Bind: bindF32BoolToBool},
+		{Fct: (func(float32, bool) float64)(nil), Bind: bindF32BoolToF64},
+		{Fct: (func(float32, bool) []float64)(nil), Bind: bindF32BoolToF64s},
+		{Fct: (func(float32, int32) bool)(nil), Bind: bindF32I32ToBool},
+		{Fct: (func(float32, int32) float64)(nil), Bind: bindF32I32ToF64},
+		{Fct: (func(float32, int32) []float64)(nil), Bind: bindF32I32ToF64s},
+		{Fct: (func(float32, int64) bool)(nil), Bind: bindF32I64ToBool},
+		{Fct: (func(float32, int64) float64)(nil), Bind: bindF32I64ToF64},
+		{Fct: (func(float32, int64) []float64)(nil), Bind: bindF32I64ToF64s},
+		{Fct: (func(float32, float32) bool)(nil), Bind: bindF32F32ToBool},
+		{Fct: (func(float32, float32) float64)(nil), Bind: bindF32F32ToF64},
+		{Fct: (func(float32, float32) []float64)(nil), Bind: bindF32F32ToF64s},
+		{Fct: (func(float32, float64) bool)(nil), Bind: bindF32F64ToBool},
+		{Fct: (func(float32, float64) float64)(nil), Bind: bindF32F64ToF64},
+		{Fct: (func(float32, float64) []float64)(nil), Bind: bindF32F64ToF64s},
+		{Fct: (func(float32, []bool) bool)(nil), Bind: bindF32BoolsToBool},
+		{Fct: (func(float32, []bool) float64)(nil), Bind: bindF32BoolsToF64},
+		{Fct: (func(float32, []bool) []float64)(nil), Bind: bindF32BoolsToF64s},
+		{Fct: (func(float32, []int32) bool)(nil), Bind: bindF32I32sToBool},
+		{Fct: (func(float32, []int32) float64)(nil), Bind: bindF32I32sToF64},
+		{Fct: (func(float32, []int32) []float64)(nil), Bind: bindF32I32sToF64s},
+		{Fct: (func(float32, []int64) bool)(nil), Bind: bindF32I64sToBool},
+		{Fct: (func(float32, []int64) float64)(nil), Bind: bindF32I64sToF64},
+		{Fct: (func(float32, []int64) []float64)(nil), Bind: bindF32I64sToF64s},
+		{Fct: (func(float32, []float32) bool)(nil), Bind: bindF32F32sToBool},
+		{Fct: (func(float32, []float32) float64)(nil), Bind: bindF32F32sToF64},
+		{Fct: (func(float32, []float32) []float64)(nil), Bind: bindF32F32sToF64s},
+		{Fct: (func(float32, []float64) bool)(nil), Bind: bindF32F64sToBool},
+		{Fct: (func(float32, []float64) float64)(nil), Bind: bindF32F64sToF64},
+		{Fct: (func(float32, []float64) []float64)(nil), Bind: bindF32F64sToF64s},
+		{Fct: (func(float64, bool) bool)(nil), Bind: bindF64BoolToBool},
+		{Fct: (func(float64, bool) float64)(nil), Bind: bindF64BoolToF64},
+		{Fct: (func(float64, bool) []float64)(nil), Bind: bindF64BoolToF64s},
+		{Fct: (func(float64, int32) bool)(nil), Bind: bindF64I32ToBool},
+		{Fct: (func(float64, int32) float64)(nil), Bind: bindF64I32ToF64},
+		{Fct: (func(float64, int32) []float64)(nil), Bind: bindF64I32ToF64s},
+		{Fct: (func(float64, int64) bool)(nil), Bind: bindF64I64ToBool},
+		{Fct: (func(float64, int64) float64)(nil), Bind: bindF64I64ToF64},
+		{Fct: (func(float64, int64) []float64)(nil), Bind: bindF64I64ToF64s},
+		{Fct: (func(float64, float32) bool)(nil), Bind: bindF64F32ToBool},
+		{Fct: (func(float64, float32) float64)(nil), Bind: bindF64F32ToF64},
+		{Fct: (func(float64, float32) []float64)(nil), Bind: bindF64F32ToF64s},
+		{Fct: (func(float64, float64) bool)(nil), Bind: bindF64F64ToBool},
+		{Fct: (func(float64, float64) float64)(nil), Bind: bindF64F64ToF64},
+		{Fct: (func(float64, float64) []float64)(nil), Bind: bindF64F64ToF64s},
+		{Fct: (func(float64, []bool) bool)(nil), Bind: bindF64BoolsToBool},
+		{Fct: (func(float64, []bool) float64)(nil), Bind: bindF64BoolsToF64},
+		{Fct: (func(float64, []bool) []float64)(nil), Bind: bindF64BoolsToF64s},
+		{Fct: (func(float64, []int32) bool)(nil), Bind: bindF64I32sToBool},
+		{Fct: (func(float64, []int32) float64)(nil), Bind: bindF64I32sToF64},
+		{Fct: (func(float64, []int32) []float64)(nil), Bind: bindF64I32sToF64s},
+		{Fct: (func(float64, []int64) bool)(nil), Bind: bindF64I64sToBool},
+		{Fct: (func(float64, []int64) float64)(nil), Bind: bindF64I64sToF64},
+		{Fct: (func(float64, []int64) []float64)(nil), Bind: bindF64I64sToF64s},
+		{Fct: (func(float64, []float32) bool)(nil), Bind: bindF64F32sToBool},
+		{Fct: (func(float64, []float32) float64)(nil), Bind: bindF64F32sToF64},
+		{Fct: (func(float64, []float32) []float64)(nil), Bind: bindF64F32sToF64s},
+		{Fct: (func(float64, []float64) bool)(nil), Bind: bindF64F64sToBool},
+		{Fct: (func(float64, []float64) float64)(nil), Bind: bindF64F64sToF64},
+		{Fct: (func(float64, []float64) []float64)(nil), Bind: bindF64F64sToF64s},
+		{Fct: (func([]bool, bool) bool)(nil), Bind: bindBoolsBoolToBool},
+		{Fct: (func([]bool, bool) float64)(nil), Bind: bindBoolsBoolToF64},
+		{Fct: (func([]bool, bool) []float64)(nil), Bind: bindBoolsBoolToF64s},
+		{Fct: (func([]bool, int32) bool)(nil), Bind: bindBoolsI32ToBool},
+		{Fct: (func([]bool, int32) float64)(nil), Bind: bindBoolsI32ToF64},
+		{Fct: (func([]bool, int32) []float64)(nil), Bind: bindBoolsI32ToF64s},
+		{Fct: (func([]bool, int64) bool)(nil), Bind: bindBoolsI64ToBool},
+		{Fct: (func([]bool, int64) float64)(nil), Bind: bindBoolsI64ToF64},
+		{Fct: (func([]bool, int64) []float64)(nil), Bind: bindBoolsI64ToF64s},
+		{Fct: (func([]bool, float32) bool)(nil), Bind: bindBoolsF32ToBool},
+		{Fct: (func([]bool, float32) float64)(nil), Bind: bindBoolsF32ToF64},
+		{Fct: (func([]bool, float32) []float64)(nil), Bind: bindBoolsF32ToF64s},
+		{Fct: (func([]bool, float64) bool)(nil), Bind: bindBoolsF64ToBool},
+		{Fct: (func([]bool, float64) float64)(nil), Bind: bindBoolsF64ToF64},
+		{Fct: (func([]bool, float64) []float64)(nil), Bind: bindBoolsF64ToF64s},
+		{Fct: (func([]bool, []bool) bool)(nil), Bind: bindBoolsBoolsToBool},
+		{Fct: (func([]bool, []bool) float64)(nil), Bind: bindBoolsBoolsToF64},
+		{Fct: (func([]bool, []bool) []float64)(nil), Bind: bindBoolsBoolsToF64s},
+		{Fct: (func([]bool, []int32) bool)(nil), Bind: bindBoolsI32sToBool},
+		{Fct: (func([]bool, []int32) float64)(nil), Bind: bindBoolsI32sToF64},
+		{Fct: (func([]bool, []int32) []float64)(nil), Bind: bindBoolsI32sToF64s},
+		{Fct: (func([]bool, []int64) bool)(nil), Bind: bindBoolsI64sToBool},
+		{Fct: (func([]bool, []int64) float64)(nil), Bind: bindBoolsI64sToF64},
+		{Fct: (func([]bool, []int64) []float64)(nil), Bind: bindBoolsI64sToF64s},
+		{Fct: (func([]bool, []float32) bool)(nil), Bind: bindBoolsF32sToBool},
+		{Fct: (func([]bool, []float32) float64)(nil), Bind: bindBoolsF32sToF64},
+		{Fct: (func([]bool, []float32) []float64)(nil), Bind: bindBoolsF32sToF64s},
+		{Fct: (func([]bool, []float64) bool)(nil), Bind: bindBoolsF64sToBool},
+		{Fct: (func([]bool, []float64) float64)(nil), Bind: bindBoolsF64sToF64},
+		{Fct: (func([]bool, []float64) []float64)(nil), Bind: bindBoolsF64sToF64s},
+		{Fct: (func([]int32, bool) bool)(nil), Bind: bindI32sBoolToBool},
+		{Fct: (func([]int32, bool) float64)(nil), Bind: bindI32sBoolToF64},
+		{Fct: (func([]int32, bool) []float64)(nil), Bind: bindI32sBoolToF64s},
+		{Fct: (func([]int32, int32) bool)(nil), Bind: bindI32sI32ToBool},
+		{Fct: (func([]int32, int32) float64)(nil), Bind: bindI32sI32ToF64},
+		{Fct: (func([]int32, int32) []float64)(nil), Bind: bindI32sI32ToF64s},
+		{Fct: (func([]int32, int64) bool)(nil), Bind: bindI32sI64ToBool},
+		{Fct: (func([]int32, int64) float64)(nil), Bind: bindI32sI64ToF64},
+		{Fct: (func([]int32, int64) []float64)(nil), Bind: bindI32sI64ToF64s},
+		{Fct: (func([]int32, float32) bool)(nil), Bind: bindI32sF32ToBool},
+		{Fct: (func([]int32, float32) float64)(nil), Bind: bindI32sF32ToF64},
+		{Fct: (func([]int32, float32) []float64)(nil), Bind: bindI32sF32ToF64s},
+		{Fct: (func([]int32, float64) bool)(nil), Bind: bindI32sF64ToBool},
+		{Fct: (func([]int32, float64) float64)(nil), Bind: bindI32sF64ToF64},
+		{Fct: (func([]int32, float64) []float64)(nil), Bind: bindI32sF64ToF64s},
+		{Fct: (func([]int32, []bool) bool)(nil), Bind: bindI32sBoolsToBool},
+		{Fct: (func([]int32, []bool) float64)(nil), Bind: bindI32sBoolsToF64},
+		{Fct: (func([]int32, []bool) []float64)(nil), Bind: bindI32sBoolsToF64s},
+		{Fct: (func([]int32, []int32) bool)(nil), Bind: bindI32sI32sToBool},
+		{Fct: (func([]int32, []int32) float64)(nil), Bind: bindI32sI32sToF64},
+		{Fct: (func([]int32, []int32) []float64)(nil), Bind: bindI32sI32sToF64s},
+		{Fct: (func([]int32, []int64) bool)(nil), Bind: bindI32sI64sToBool},
+		{Fct: (func([]int32, []int64) float64)(nil), Bind: bindI32sI64sToF64},
+		{Fct: (func([]int32, []int64) []float64)(nil), Bind: bindI32sI64sToF64s},
+		{Fct: (func([]int32, []float32) bool)(nil), Bind: bindI32sF32sToBool},
+		{Fct: (func([]int32, []float32) float64)(nil), Bind: bindI32sF32sToF64},
+		{Fct: (func([]int32, []float32) []float64)(nil), Bind: bindI32sF32sToF64s},
+		{Fct: (func([]int32, []float64) bool)(nil), Bind: bindI32sF64sToBool},
+		{Fct: (func([]int32, []float64) float64)(nil), Bind: bindI32sF64sToF64},
+		{Fct: (func([]int32, []float64) []float64)(nil), Bind: bindI32sF64sToF64s},
+		{Fct: (func([]int64, bool) bool)(nil), Bind: bindI64sBoolToBool},
+		{Fct: (func([]int64, bool) float64)(nil), Bind: bindI64sBoolToF64},
+		{Fct: (func([]int64, bool) []float64)(nil), Bind: bindI64sBoolToF64s},
+		{Fct: (func([]int64, int32) bool)(nil), Bind: bindI64sI32ToBool},
+		{Fct: (func([]int64, int32) float64)(nil), Bind: bindI64sI32ToF64},
+		{Fct: (func([]int64, int32) []float64)(nil), Bind: bindI64sI32ToF64s},
+		{Fct: (func([]int64, int64) bool)(nil), Bind: bindI64sI64ToBool},
+		{Fct: (func([]int64, int64) float64)(nil), Bind: bindI64sI64ToF64},
+		{Fct: (func([]int64, int64) []float64)(nil), Bind: bindI64sI64ToF64s},
+		{Fct: (func([]int64, float32) bool)(nil), Bind: bindI64sF32ToBool},
+		{Fct: (func([]int64, float32) float64)(nil), Bind: bindI64sF32ToF64},
+		{Fct: (func([]int64, float32) []float64)(nil), Bind: bindI64sF32ToF64s},
+		{Fct: (func([]int64, float64) bool)(nil), Bind: bindI64sF64ToBool},
+		{Fct: (func([]int64, float64) float64)(nil), Bind: bindI64sF64ToF64},
+		{Fct: (func([]int64, float64) []float64)(nil), Bind: bindI64sF64ToF64s},
+		{Fct: (func([]int64, []bool) bool)(nil), Bind: bindI64sBoolsToBool},
+		{Fct: (func([]int64, []bool) float64)(nil), Bind: bindI64sBoolsToF64},
+		{Fct: (func([]int64, []bool) []float64)(nil), Bind: bindI64sBoolsToF64s},
+		{Fct: (func([]int64, []int32) bool)(nil), Bind: bindI64sI32sToBool},
+		{Fct: (func([]int64, []int32) float64)(nil), Bind: bindI64sI32sToF64},
+		{Fct: (func([]int64, []int32) []float64)(nil), Bind: bindI64sI32sToF64s},
+		{Fct: (func([]int64, []int64) bool)(nil), Bind: bindI64sI64sToBool},
+		{Fct: (func([]int64, []int64) float64)(nil), Bind: bindI64sI64sToF64},
+		{Fct: (func([]int64, []int64) []float64)(nil), Bind: bindI64sI64sToF64s},
+		{Fct: (func([]int64, []float32) bool)(nil), Bind: bindI64sF32sToBool},
+		{Fct: (func([]int64, []float32) float64)(nil), Bind: bindI64sF32sToF64},
+		{Fct: (func([]int64, []float32) []float64)(nil), Bind: bindI64sF32sToF64s},
+		{Fct: (func([]int64, []float64) bool)(nil), Bind: bindI64sF64sToBool},
+		{Fct: (func([]int64, []float64) float64)(nil), Bind: bindI64sF64sToF64},
+		{Fct: (func([]int64, []float64) []float64)(nil), Bind: bindI64sF64sToF64s},
+		{Fct: (func([]float32, bool) bool)(nil), Bind: bindF32sBoolToBool},
+		{Fct: (func([]float32, bool) float64)(nil), Bind: bindF32sBoolToF64},
+		{Fct: (func([]float32, bool) []float64)(nil), Bind: bindF32sBoolToF64s},
+		{Fct: (func([]float32, int32) bool)(nil), Bind: bindF32sI32ToBool},
+		{Fct: (func([]float32, int32) float64)(nil), Bind: bindF32sI32ToF64},
+		{Fct: (func([]float32, int32) []float64)(nil), Bind: bindF32sI32ToF64s},
+		{Fct: (func([]float32, int64) bool)(nil), Bind: bindF32sI64ToBool},
+		{Fct: (func([]float32, int64) float64)(nil), Bind: bindF32sI64ToF64},
+		{Fct: (func([]float32, int64) []float64)(nil), Bind: bindF32sI64ToF64s},
+		{Fct: (func([]float32, float32) bool)(nil), Bind: bindF32sF32ToBool},
+		{Fct: (func([]float32, float32) float64)(nil), Bind: bindF32sF32ToF64},
+		{Fct: (func([]float32, float32) []float64)(nil), Bind: bindF32sF32ToF64s},
+		{Fct: (func([]float32, float64) bool)(nil), Bind: bindF32sF64ToBool},
+		{Fct: (func([]float32, float64) float64)(nil), Bind: bindF32sF64ToF64},
+		{Fct: (func([]float32, float64) []float64)(nil), Bind: bindF32sF64ToF64s},
+		{Fct: (func([]float32, []bool) bool)(nil), Bind: bindF32sBoolsToBool},
+		{Fct: (func([]float32, []bool) float64)(nil), Bind: bindF32sBoolsToF64},
+		{Fct: (func([]float32, []bool) []float64)(nil), Bind: bindF32sBoolsToF64s},
+		{Fct: (func([]float32, []int32) bool)(nil), Bind: bindF32sI32sToBool},
+		{Fct: (func([]float32, []int32) float64)(nil), Bind: bindF32sI32sToF64},
+		{Fct: (func([]float32, []int32) []float64)(nil), Bind: bindF32sI32sToF64s},
+		{Fct: (func([]float32, []int64) bool)(nil), Bind: bindF32sI64sToBool},
+		{Fct: (func([]float32, []int64) float64)(nil), Bind: bindF32sI64sToF64},
+		{Fct: (func([]float32, []int64) []float64)(nil), Bind: bindF32sI64sToF64s},
+		{Fct: (func([]float32, []float32) bool)(nil), Bind: bindF32sF32sToBool},
+		{Fct: (func([]float32, []float32) float64)(nil), Bind: bindF32sF32sToF64},
+		{Fct: (func([]float32, []float32) []float64)(nil), Bind: bindF32sF32sToF64s},
+		{Fct: (func([]float32, []float64) bool)(nil), Bind: bindF32sF64sToBool},
+		{Fct: (func([]float32, []float64) float64)(nil), Bind: bindF32sF64sToF64},
+		{Fct: (func([]float32, []float64) []float64)(nil), Bind: bindF32sF64sToF64s},
+		{Fct: (func([]float64, bool) bool)(nil), Bind: bindF64sBoolToBool},
+		{Fct: (func([]float64, bool) float64)(nil), Bind: bindF64sBoolToF64},
+		{Fct: (func([]float64, bool) []float64)(nil), Bind: bindF64sBoolToF64s},
+		{Fct: (func([]float64, int32) bool)(nil), Bind: bindF64sI32ToBool},
+		{Fct: (func([]float64, int32) float64)(nil), Bind: bindF64sI32ToF64},
+		{Fct: (func([]float64, int32) []float64)(nil), Bind: bindF64sI32ToF64s},
+		{Fct: (func([]float64, int64) bool)(nil), Bind: bindF64sI64ToBool},
+		{Fct: (func([]float64, int64) float64)(nil), Bind: bindF64sI64ToF64},
+		{Fct: (func([]float64, int64) []float64)(nil), Bind: bindF64sI64ToF64s},
+		{Fct: (func([]float64, float32) bool)(nil), Bind: bindF64sF32ToBool},
+		{Fct: (func([]float64, float32) float64)(nil), Bind: bindF64sF32ToF64},
+		{Fct: (func([]float64, float32) []float64)(nil), Bind: bindF64sF32ToF64s},
+		{Fct: (func([]float64, float64) bool)(nil), Bind: bindF64sF64ToBool},
+		{Fct: (func([]float64, float64) float64)(nil), Bind: bindF64sF64ToF64},
+		{Fct: (func([]float64, float64) []float64)(nil), Bind: bindF64sF64ToF64s},
+		{Fct: (func([]float64, []bool) bool)(nil), Bind: bindF64sBoolsToBool},
+		{Fct: (func([]float64, []bool) float64)(nil), Bind: bindF64sBoolsToF64},
+		{Fct: (func([]float64, []bool) []float64)(nil), Bind: bindF64sBoolsToF64s},
+		{Fct: (func([]float64, []int32) bool)(nil), Bind: bindF64sI32sToBool},
+		{Fct: (func([]float64, []int32) float64)(nil), Bind: bindF64sI32sToF64},
+		{Fct: (func([]float64, []int32) []float64)(nil), Bind: bindF64sI32sToF64s},
+		{Fct: (func([]float64, []int64) bool)(nil), Bind: bindF64sI64sToBool},
+		{Fct: (func([]float64, []int64) float64)(nil), Bind: bindF64sI64sToF64},
+		{Fct: (func([]float64, []int64) []float64)(nil), Bind: bindF64sI64sToF64s},
+		{Fct: (func([]float64, []float32) bool)(nil), Bind: bindF64sF32sToBool},
+		{Fct: (func([]float64, []float32) float64)(nil), Bind: bindF64sF32sToF64},
+		{Fct: (func([]float64, []float32) []float64)(nil), Bind: bindF64sF32sToF64s},
+		{Fct: (func([]float64, []float64) bool)(nil), Bind: bindF64sF64sToBool},
+		{Fct: (func([]float64, []float64) float64)(nil), Bind: bindF64sF64sToF64},
+		{Fct: (func([]float64, []float64) []float64)(nil), Bind: bindF64sF64sToF64s},
+		{Fct: (func(bool, bool, bool) bool)(nil), Bind: bindBoolBoolBoolToBool},
+		{Fct: (func(bool, bool, bool) float64)(nil), Bind: bindBoolBoolBoolToF64},
+		{Fct: (func(bool, bool, bool) []float64)(nil), Bind: bindBoolBoolBoolToF64s},
+		{Fct: (func(int32, int32, int32) bool)(nil), Bind: bindI32I32I32ToBool},
+		{Fct: (func(int32, int32, int32) float64)(nil), Bind: bindI32I32I32ToF64},
+		{Fct: (func(int32, int32, int32) []float64)(nil), Bind: bindI32I32I32ToF64s},
+		{Fct: (func(int64, int64, int64) bool)(nil), Bind: bindI64I64I64ToBool},
+		{Fct: (func(int64, int64, int64) float64)(nil), Bind: bindI64I64I64ToF64},
+		{Fct: (func(int64, int64, int64) []float64)(nil), Bind: bindI64I64I64ToF64s},
+		{Fct: (func(float32, float32, float32) bool)(nil), Bind: bindF32F32F32ToBool},
+		{Fct: (func(float32, float32, float32) float64)(nil), Bind: bindF32F32F32ToF64},
+		{Fct: (func(float32, float32, float32) []float64)(nil), Bind: bindF32F32F32ToF64s},
+		{Fct: (func(float64, float64, float64) bool)(nil), Bind: bindF64F64F64ToBool},
+		{Fct: (func(float64, float64, float64) float64)(nil), Bind: bindF64F64F64ToF64},
+		{Fct: (func(float64, float64, float64) []float64)(nil), Bind: bindF64F64F64ToF64s},
+		{Fct: (func([]bool, []bool, []bool) bool)(nil), Bind: bindBoolsBoolsBoolsToBool},
+		{Fct: (func([]bool, []bool, []bool) float64)(nil), Bind: bindBoolsBoolsBoolsToF64},
+		{Fct: (func([]bool, []bool, []bool) []float64)(nil), Bind: bindBoolsBoolsBoolsToF64s},
+		{Fct: (func([]int32, []int32, []int32) bool)(nil), Bind: bindI32sI32sI32sToBool},
+		{Fct: (func([]int32, []int32, []int32) float64)(nil), Bind: bindI32sI32sI32sToF64},
+		{Fct: (func([]int32, []int32, []int32) []float64)(nil), Bind: bindI32sI32sI32sToF64s},
+		{Fct: (func([]int64, []int64, []int64) bool)(nil), Bind: bindI64sI64sI64sToBool},
+		{Fct: (func([]int64, []int64, []int64) float64)(nil), Bind: bindI64sI64sI64sToF64},
+		{Fct: (func([]int64, []int64, []int64) []float64)(nil), Bind: bindI64sI64sI64sToF64s},
+		{Fct: (func([]float32, []float32, []float32) bool)(nil), Bind: bindF32sF32sF32sToBool},
+		{Fct: (func([]float32, []float32, []float32) float64)(nil), Bind: bindF32sF32sF32sToF64},
+		{Fct: (func([]float32, []float32, []float32) []float64)(nil), Bind: bindF32sF32sF32sToF64s},
+		{Fct: (func([]float64, []float64, []float64) bool)(nil), Bind: bindF64sF64sF64sToBool},
+		{Fct: (func([]float64, []float64, []float64) float64)(nil), Bind: bindF64sF64sF64sToF64},
+		{Fct: (func([]float64, []float64, []float64) []float64)(nil), Bind: bindF64sF64sF64sToF64s},
+		{Fct: (func(bool, bool, bool, bool) bool)(nil), Bind: bindBoolBoolBoolBoolToBool},
+		{Fct: (func(bool, bool, bool, bool) float64)(nil), Bind: bindBoolBoolBoolBoolToF64},
+		{Fct: (func(bool, bool, bool, bool) []float64)(nil), Bind: bindBoolBoolBoolBoolToF64s},
+		{Fct: (func(int32, int32, int32, int32) bool)(nil), Bind: bindI32I32I32I32ToBool},
+		{Fct: (func(int32, int32, int32, int32) float64)(nil), Bind: bindI32I32I32I32ToF64},
+		{Fct: (func(int32, int32, int32, int32) []float64)(nil), Bind: bindI32I32I32I32ToF64s},
+		{Fct: (func(int64, int64, int64, int64) bool)(nil), Bind: bindI64I64I64I64ToBool},
+		{Fct: (func(int64, int64, int64, int64) float64)(nil), Bind: bindI64I64I64I64ToF64},
+		{Fct: (func(int64, int64, int64, int64) []float64)(nil), Bind: bindI64I64I64I64ToF64s},
+		{Fct: (func(float32, float32, float32, float32) bool)(nil), Bind: bindF32F32F32F32ToBool},
+		{Fct: (func(float32, float32, float32, float32) float64)(nil), Bind: bindF32F32F32F32ToF64},
+		{Fct: (func(float32, float32, float32, float32) []float64)(nil), Bind: bindF32F32F32F32ToF64s},
+		{Fct: (func(float64, float64, float64, float64) bool)(nil), Bind: bindF64F64F64F64ToBool},
+		{Fct: (func(float64, float64, float64, float64) float64)(nil), Bind: bindF64F64F64F64ToF64},
+		{Fct: (func(float64, float64, float64, float64) []float64)(nil), Bind: bindF64F64F64F64ToF64s},
+		{Fct: (func([]bool, []bool, []bool, []bool) bool)(nil), Bind: bindBoolsBoolsBoolsBoolsToBool},
+		{Fct: (func([]bool, []bool, []bool, []bool) float64)(nil), Bind: bindBoolsBoolsBoolsBoolsToF64},
+		{Fct: (func([]bool, []bool, []bool, []bool) []float64)(nil), Bind: bindBoolsBoolsBoolsBoolsToF64s},
+		{Fct: (func([]int32, []int32, []int32, []int32) bool)(nil), Bind: bindI32sI32sI32sI32sToBool},
+		{Fct: (func([]int32, []int32, []int32, []int32) float64)(nil), Bind: bindI32sI32sI32sI32sToF64},
+		{Fct: (func([]int32, []int32, []int32, []int32) []float64)(nil), Bind: bindI32sI32sI32sI32sToF64s},
+		{Fct: (func([]int64, []int64, []int64, []int64) bool)(nil), Bind: bindI64sI64sI64sI64sToBool},
+		{Fct: (func([]int64, []int64, []int64, []int64) float64)(nil), Bind: bindI64sI64sI64sI64sToF64},
+		{Fct: (func([]int64, []int64, []int64, []int64) []float64)(nil), Bind: bindI64sI64sI64sI64sToF64s},
+		{Fct: (func([]float32, []float32, []float32, []float32) bool)(nil), Bind: bindF32sF32sF32sF32sToBool},
+		{Fct: (func([]float32, []float32, []float32, []float32) float64)(nil), Bind: bindF32sF32sF32sF32sToF64},
+		{Fct: (func([]float32, []float32, []float32, []float32) []float64)(nil), Bind: bindF32sF32sF32sF32sToF64s},
+		{Fct: (func([]float64, []float64, []float64, []float64) bool)(nil), Bind: bindF64sF64sF64sF64sToBool},
+		{Fct: (func([]float64, []float64, []float64, []float64) float64)(nil), Bind: bindF64sF64sF64sF64sToF64},
+		{Fct: (func([]float64, []float64, []float64, []float64) []float64)(nil), Bind: bindF64sF64sF64sF64sToF64s},
+	})
+}
+
+func bindToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func() bool)
+	return func() bool { return f() }
+}
+
+func bindToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func() float64)
+	return func() float64 { return f() }
+}
+
+func bindToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func() []float64)
+	return func() []float64 { return f() }
+}
+
+func bindBoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool) bool)
+	x1 := ptrs[0].(*bool)
+	return func() bool { return f(*x1) }
+}
+
+func bindBoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool) float64)
+	x1 := ptrs[0].(*bool)
+	return func() float64 { return f(*x1) }
+}
+
+func bindBoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool) []float64)
+	x1 := ptrs[0].(*bool)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindI32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32) bool)
+	x1 := ptrs[0].(*int32)
+	return func() bool { return f(*x1) }
+}
+
+func bindI32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32) float64)
+	x1 := ptrs[0].(*int32)
+	return func() float64 { return f(*x1) }
+}
+
+func bindI32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32) []float64)
+	x1 := ptrs[0].(*int32)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindI64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64) bool)
+	x1 := ptrs[0].(*int64)
+	return func() bool { return f(*x1) }
+}
+
+func bindI64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64) float64)
+	x1 := ptrs[0].(*int64)
+	return func() float64 { return f(*x1) }
+}
+
+func bindI64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64) []float64)
+	x1 := ptrs[0].(*int64)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindF32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32) bool)
+	x1 := ptrs[0].(*float32)
+	return func() bool { return f(*x1) }
+}
+
+func bindF32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32) float64)
+	x1 := ptrs[0].(*float32)
+	return func() float64 { return f(*x1) }
+}
+
+func bindF32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32) []float64)
+	x1 := ptrs[0].(*float32)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindF64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64) bool)
+	x1 := ptrs[0].(*float64)
+	return func() bool { return f(*x1) }
+}
+
+func bindF64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64) float64)
+	x1 := ptrs[0].(*float64)
+	return func() float64 { return f(*x1) }
+}
+
+func bindF64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64) []float64)
+	x1 := ptrs[0].(*float64)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindBoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool) bool)
+	x1 := ptrs[0].(*[]bool)
+	return func() bool { return f(*x1) }
+}
+
+func bindBoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool) float64)
+	x1 := ptrs[0].(*[]bool)
+	return func() float64 { return f(*x1) }
+}
+
+func bindBoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool) []float64)
+	x1 := ptrs[0].(*[]bool)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindI32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32) bool)
+	x1 := ptrs[0].(*[]int32)
+	return func() bool { return f(*x1) }
+}
+
+func bindI32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32) float64)
+	x1 := ptrs[0].(*[]int32)
+	return func() float64 { return f(*x1) }
+}
+
+func bindI32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32) []float64)
+	x1 := ptrs[0].(*[]int32)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindI64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64) bool)
+	x1 := ptrs[0].(*[]int64)
+	return func() bool { return f(*x1) }
+}
+
+func bindI64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64) float64)
+	x1 := ptrs[0].(*[]int64)
+	return func() float64 { return f(*x1) }
+}
+
+func bindI64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64) []float64)
+	x1 := ptrs[0].(*[]int64)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindF32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32) bool)
+	x1 := ptrs[0].(*[]float32)
+	return func() bool { return f(*x1) }
+}
+
+func bindF32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32) float64)
+	x1 := ptrs[0].(*[]float32)
+	return func() float64 { return f(*x1) }
+}
+
+func bindF32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32) []float64)
+	x1 := ptrs[0].(*[]float32)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindF64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64) bool)
+	x1 := ptrs[0].(*[]float64)
+	return func() bool { return f(*x1) }
+}
+
+func bindF64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64) float64)
+	x1 := ptrs[0].(*[]float64)
+	return func() float64 { return f(*x1) }
+}
+
+func bindF64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64) []float64)
+	x1 := ptrs[0].(*[]float64)
+	return func() []float64 { return f(*x1) }
+}
+
+func bindBoolBoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, bool) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolBoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, bool) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolBoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, bool) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolI32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, int32) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolI32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, int32) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolI32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, int32) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolI64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, int64) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolI64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, int64) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolI64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, int64) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolF32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, float32) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolF32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, float32) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolF32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, float32) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolF64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, float64) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolF64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, float64) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolF64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, float64) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolBoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []bool) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolBoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []bool) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolBoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []bool) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolI32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []int32) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolI32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []int32) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolI32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []int32) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolI64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []int64) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolI64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []int64) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolI64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []int64) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolF32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []float32) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolF32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []float32) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolF32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []float32) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolF64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []float64) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolF64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []float64) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolF64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, []float64) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32BoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, bool) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32BoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, bool) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32BoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, bool) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32I32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int32) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32I32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int32) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32I32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int32) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32I64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int64) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32I64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int64) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32I64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int64) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32F32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, float32) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32F32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, float32) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32F32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, float32) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32F64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, float64) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32F64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, float64) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32F64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, float64) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32BoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []bool) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32BoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []bool) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32BoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []bool) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32I32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []int32) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32I32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []int32) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32I32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []int32) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32I64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []int64) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32I64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []int64) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32I64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []int64) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32F32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []float32) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32F32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []float32) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32F32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []float32) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32F64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []float64) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32F64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []float64) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32F64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, []float64) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64BoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, bool) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64BoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, bool) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64BoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, bool) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64I32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int32) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64I32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int32) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64I32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int32) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64I64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int64) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64I64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int64) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64I64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int64) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64F32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, float32) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64F32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, float32) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64F32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, float32) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64F64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, float64) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64F64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, float64) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64F64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, float64) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64BoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []bool) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64BoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []bool) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64BoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []bool) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64I32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []int32) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64I32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []int32) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64I32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []int32) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64I64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []int64) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64I64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []int64) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64I64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []int64) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64F32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []float32) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64F32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []float32) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64F32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []float32) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64F64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []float64) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64F64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []float64) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64F64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, []float64) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32BoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, bool) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32BoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, bool) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32BoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, bool) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32I32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, int32) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32I32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, int32) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32I32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, int32) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32I64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, int64) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32I64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, int64) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32I64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, int64) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32F32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float32) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32F32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float32) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32F32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float32) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32F64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float64) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32F64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float64) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32F64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float64) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32BoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []bool) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32BoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []bool) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32BoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []bool) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32I32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []int32) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32I32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []int32) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32I32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []int32) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32I64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []int64) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32I64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []int64) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32I64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []int64) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32F32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []float32) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32F32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []float32) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32F32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []float32) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32F64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []float64) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32F64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []float64) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32F64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, []float64) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64BoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, bool) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64BoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, bool) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64BoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, bool) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64I32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, int32) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64I32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, int32) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64I32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, int32) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64I64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, int64) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64I64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, int64) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64I64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, int64) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64F32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float32) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64F32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float32) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64F32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float32) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64F64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float64) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64F64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float64) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64F64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float64) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64BoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []bool) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64BoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []bool) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64BoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []bool) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64I32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []int32) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64I32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []int32) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64I32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []int32) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64I64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []int64) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64I64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []int64) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64I64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []int64) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64F32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []float32) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64F32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []float32) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64F32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []float32) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64F64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []float64) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64F64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []float64) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64F64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, []float64) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsBoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, bool) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsBoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, bool) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsBoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, bool) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsI32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, int32) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsI32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, int32) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsI32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, int32) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsI64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, int64) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsI64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, int64) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsI64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, int64) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsF32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, float32) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsF32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, float32) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsF32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, float32) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsF64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, float64) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsF64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, float64) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsF64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, float64) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsBoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []bool) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsBoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []bool) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsBoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []bool) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsI32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []int32) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsI32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []int32) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsI32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []int32) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsI64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []int64) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsI64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []int64) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsI64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []int64) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsF32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []float32) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsF32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []float32) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsF32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []float32) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsF64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []float64) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindBoolsF64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []float64) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindBoolsF64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []float64) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sBoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, bool) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sBoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, bool) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sBoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, bool) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sI32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, int32) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sI32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, int32) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sI32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, int32) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sI64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, int64) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sI64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, int64) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sI64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, int64) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sF32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, float32) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sF32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, float32) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sF32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, float32) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sF64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, float64) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sF64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, float64) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sF64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, float64) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sBoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []bool) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sBoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []bool) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sBoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []bool) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sI32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int32) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sI32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int32) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sI32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int32) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sI64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int64) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sI64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int64) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sI64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int64) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sF32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []float32) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sF32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []float32) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sF32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []float32) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI32sF64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []float64) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI32sF64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []float64) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI32sF64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []float64) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sBoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, bool) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sBoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, bool) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sBoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, bool) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sI32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, int32) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sI32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, int32) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sI32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, int32) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sI64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, int64) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sI64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, int64) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sI64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, int64) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sF32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, float32) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sF32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, float32) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sF32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, float32) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sF64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, float64) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sF64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, float64) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sF64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, float64) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sBoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []bool) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sBoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []bool) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sBoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []bool) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sI32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int32) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sI32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int32) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sI32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int32) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sI64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int64) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sI64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int64) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sI64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int64) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sF32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []float32) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sF32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []float32) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sF32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []float32) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindI64sF64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []float64) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindI64sF64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []float64) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindI64sF64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []float64) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sBoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, bool) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sBoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, bool) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sBoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, bool) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sI32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, int32) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sI32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, int32) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sI32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, int32) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sI64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, int64) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sI64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, int64) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sI64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, int64) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sF32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, float32) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sF32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, float32) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sF32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, float32) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sF64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, float64) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sF64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, float64) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sF64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, float64) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sBoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []bool) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sBoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []bool) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sBoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []bool) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sI32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []int32) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sI32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []int32) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sI32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []int32) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sI64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []int64) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sI64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []int64) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sI64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []int64) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sF32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float32) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sF32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float32) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sF32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float32) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF32sF64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float64) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF32sF64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float64) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF32sF64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float64) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sBoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, bool) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sBoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, bool) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sBoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, bool) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sI32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, int32) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sI32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, int32) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sI32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, int32) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sI64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, int64) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sI64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, int64) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sI64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, int64) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sF32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, float32) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sF32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, float32) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sF32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, float32) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sF64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, float64) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sF64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, float64) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sF64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, float64) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sBoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []bool) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]bool)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sBoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []bool) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]bool)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sBoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []bool) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]bool)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sI32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []int32) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]int32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sI32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []int32) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]int32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sI32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []int32) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]int32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sI64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []int64) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]int64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sI64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []int64) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]int64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sI64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []int64) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]int64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sF32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float32) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float32)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sF32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float32) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float32)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sF32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float32) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float32)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindF64sF64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float64) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float64)
+	return func() bool { return f(*x1, *x2) }
+}
+
+func bindF64sF64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float64) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float64)
+	return func() float64 { return f(*x1, *x2) }
+}
+
+func bindF64sF64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float64) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float64)
+	return func() []float64 { return f(*x1, *x2) }
+}
+
+func bindBoolBoolBoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, bool, bool) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*bool)
+	x3 := ptrs[2].(*bool)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindBoolBoolBoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, bool, bool) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*bool)
+	x3 := ptrs[2].(*bool)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindBoolBoolBoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, bool, bool) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*bool)
+	x3 := ptrs[2].(*bool)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindI32I32I32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int32, int32) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int32)
+	x3 := ptrs[2].(*int32)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindI32I32I32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int32, int32) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int32)
+	x3 := ptrs[2].(*int32)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindI32I32I32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int32, int32) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int32)
+	x3 := ptrs[2].(*int32)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindI64I64I64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int64, int64) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int64)
+	x3 := ptrs[2].(*int64)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindI64I64I64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int64, int64) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int64)
+	x3 := ptrs[2].(*int64)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindI64I64I64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int64, int64) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int64)
+	x3 := ptrs[2].(*int64)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindF32F32F32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float32, float32) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float32)
+	x3 := ptrs[2].(*float32)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindF32F32F32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float32, float32) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float32)
+	x3 := ptrs[2].(*float32)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindF32F32F32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float32, float32) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float32)
+	x3 := ptrs[2].(*float32)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindF64F64F64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float64, float64) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float64)
+	x3 := ptrs[2].(*float64)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindF64F64F64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float64, float64) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float64)
+	x3 := ptrs[2].(*float64)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindF64F64F64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float64, float64) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float64)
+	x3 := ptrs[2].(*float64)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindBoolsBoolsBoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []bool, []bool) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]bool)
+	x3 := ptrs[2].(*[]bool)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindBoolsBoolsBoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []bool, []bool) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]bool)
+	x3 := ptrs[2].(*[]bool)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindBoolsBoolsBoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []bool, []bool) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]bool)
+	x3 := ptrs[2].(*[]bool)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindI32sI32sI32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int32, []int32) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int32)
+	x3 := ptrs[2].(*[]int32)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindI32sI32sI32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int32, []int32) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int32)
+	x3 := ptrs[2].(*[]int32)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindI32sI32sI32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int32, []int32) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int32)
+	x3 := ptrs[2].(*[]int32)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindI64sI64sI64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int64, []int64) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int64)
+	x3 := ptrs[2].(*[]int64)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindI64sI64sI64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int64, []int64) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int64)
+	x3 := ptrs[2].(*[]int64)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindI64sI64sI64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int64, []int64) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int64)
+	x3 := ptrs[2].(*[]int64)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindF32sF32sF32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float32, []float32) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float32)
+	x3 := ptrs[2].(*[]float32)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindF32sF32sF32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float32, []float32) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float32)
+	x3 := ptrs[2].(*[]float32)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindF32sF32sF32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float32, []float32) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float32)
+	x3 := ptrs[2].(*[]float32)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindF64sF64sF64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float64, []float64) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float64)
+	x3 := ptrs[2].(*[]float64)
+	return func() bool { return f(*x1, *x2, *x3) }
+}
+
+func bindF64sF64sF64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float64, []float64) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float64)
+	x3 := ptrs[2].(*[]float64)
+	return func() float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindF64sF64sF64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float64, []float64) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float64)
+	x3 := ptrs[2].(*[]float64)
+	return func() []float64 { return f(*x1, *x2, *x3) }
+}
+
+func bindBoolBoolBoolBoolToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, bool, bool, bool) bool)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*bool)
+	x3 := ptrs[2].(*bool)
+	x4 := ptrs[3].(*bool)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindBoolBoolBoolBoolToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, bool, bool, bool) float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*bool)
+	x3 := ptrs[2].(*bool)
+	x4 := ptrs[3].(*bool)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindBoolBoolBoolBoolToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(bool, bool, bool, bool) []float64)
+	x1 := ptrs[0].(*bool)
+	x2 := ptrs[1].(*bool)
+	x3 := ptrs[2].(*bool)
+	x4 := ptrs[3].(*bool)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI32I32I32I32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int32, int32, int32) bool)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int32)
+	x3 := ptrs[2].(*int32)
+	x4 := ptrs[3].(*int32)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI32I32I32I32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int32, int32, int32) float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int32)
+	x3 := ptrs[2].(*int32)
+	x4 := ptrs[3].(*int32)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI32I32I32I32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int32, int32, int32, int32) []float64)
+	x1 := ptrs[0].(*int32)
+	x2 := ptrs[1].(*int32)
+	x3 := ptrs[2].(*int32)
+	x4 := ptrs[3].(*int32)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI64I64I64I64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int64, int64, int64) bool)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int64)
+	x3 := ptrs[2].(*int64)
+	x4 := ptrs[3].(*int64)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI64I64I64I64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int64, int64, int64) float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int64)
+	x3 := ptrs[2].(*int64)
+	x4 := ptrs[3].(*int64)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI64I64I64I64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(int64, int64, int64, int64) []float64)
+	x1 := ptrs[0].(*int64)
+	x2 := ptrs[1].(*int64)
+	x3 := ptrs[2].(*int64)
+	x4 := ptrs[3].(*int64)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF32F32F32F32ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float32, float32, float32) bool)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float32)
+	x3 := ptrs[2].(*float32)
+	x4 := ptrs[3].(*float32)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF32F32F32F32ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float32, float32, float32) float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float32)
+	x3 := ptrs[2].(*float32)
+	x4 := ptrs[3].(*float32)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF32F32F32F32ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float32, float32, float32, float32) []float64)
+	x1 := ptrs[0].(*float32)
+	x2 := ptrs[1].(*float32)
+	x3 := ptrs[2].(*float32)
+	x4 := ptrs[3].(*float32)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF64F64F64F64ToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float64, float64, float64) bool)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float64)
+	x3 := ptrs[2].(*float64)
+	x4 := ptrs[3].(*float64)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF64F64F64F64ToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float64, float64, float64) float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float64)
+	x3 := ptrs[2].(*float64)
+	x4 := ptrs[3].(*float64)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF64F64F64F64ToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func(float64, float64, float64, float64) []float64)
+	x1 := ptrs[0].(*float64)
+	x2 := ptrs[1].(*float64)
+	x3 := ptrs[2].(*float64)
+	x4 := ptrs[3].(*float64)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindBoolsBoolsBoolsBoolsToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []bool, []bool, []bool) bool)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]bool)
+	x3 := ptrs[2].(*[]bool)
+	x4 := ptrs[3].(*[]bool)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindBoolsBoolsBoolsBoolsToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []bool, []bool, []bool) float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]bool)
+	x3 := ptrs[2].(*[]bool)
+	x4 := ptrs[3].(*[]bool)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindBoolsBoolsBoolsBoolsToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]bool, []bool, []bool, []bool) []float64)
+	x1 := ptrs[0].(*[]bool)
+	x2 := ptrs[1].(*[]bool)
+	x3 := ptrs[2].(*[]bool)
+	x4 := ptrs[3].(*[]bool)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI32sI32sI32sI32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int32, []int32, []int32) bool)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int32)
+	x3 := ptrs[2].(*[]int32)
+	x4 := ptrs[3].(*[]int32)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI32sI32sI32sI32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int32, []int32, []int32) float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int32)
+	x3 := ptrs[2].(*[]int32)
+	x4 := ptrs[3].(*[]int32)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI32sI32sI32sI32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int32, []int32, []int32, []int32) []float64)
+	x1 := ptrs[0].(*[]int32)
+	x2 := ptrs[1].(*[]int32)
+	x3 := ptrs[2].(*[]int32)
+	x4 := ptrs[3].(*[]int32)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI64sI64sI64sI64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int64, []int64, []int64) bool)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int64)
+	x3 := ptrs[2].(*[]int64)
+	x4 := ptrs[3].(*[]int64)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI64sI64sI64sI64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int64, []int64, []int64) float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int64)
+	x3 := ptrs[2].(*[]int64)
+	x4 := ptrs[3].(*[]int64)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindI64sI64sI64sI64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]int64, []int64, []int64, []int64) []float64)
+	x1 := ptrs[0].(*[]int64)
+	x2 := ptrs[1].(*[]int64)
+	x3 := ptrs[2].(*[]int64)
+	x4 := ptrs[3].(*[]int64)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF32sF32sF32sF32sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float32, []float32, []float32) bool)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float32)
+	x3 := ptrs[2].(*[]float32)
+	x4 := ptrs[3].(*[]float32)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF32sF32sF32sF32sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float32, []float32, []float32) float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float32)
+	x3 := ptrs[2].(*[]float32)
+	x4 := ptrs[3].(*[]float32)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF32sF32sF32sF32sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float32, []float32, []float32, []float32) []float64)
+	x1 := ptrs[0].(*[]float32)
+	x2 := ptrs[1].(*[]float32)
+	x3 := ptrs[2].(*[]float32)
+	x4 := ptrs[3].(*[]float32)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF64sF64sF64sF64sToBool(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float64, []float64, []float64) bool)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float64)
+	x3 := ptrs[2].(*[]float64)
+	x4 := ptrs[3].(*[]float64)
+	return func() bool { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF64sF64sF64sF64sToF64(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float64, []float64, []float64) float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float64)
+	x3 := ptrs[2].(*[]float64)
+	x4 := ptrs[3].(*[]float64)
+	return func() float64 { return f(*x1, *x2, *x3, *x4) }
+}
+
+func bindF64sF64sF64sF64sToF64s(fct interface{}, ptrs []interface{}) interface{} {
+	f := fct.(func([]float64, []float64, []float64, []float64) []float64)
+	x1 := ptrs[0].(*[]float64)
+	x2 := ptrs[1].(*[]float64)
+	x3 := ptrs[2].(*[]float64)
+	x4 := ptrs[3].(*[]float64)
+	return func() []float64 { return f(*x1, *x2, *x3, *x4) }
+}
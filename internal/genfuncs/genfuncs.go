@@ -0,0 +1,176 @@
+// Package genfuncs generates the binding functions of the fast
+// rfunc.Formula of the TreeFunc signatures, ie of the functions
+// taking up to MaxArgs arguments of type bool, int32, int64,
+// float32, float64 or their slices, and returning a bool, a
+// float64 or a []float64.
+package genfuncs
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+// Type of arguments and returned values.
+type typ struct {
+	Name string // Name used in the function names.
+	Def  string // Go type.
+}
+
+var (
+	argTypes = []typ{
+		{"Bool", "bool"},
+		{"I32", "int32"},
+		{"I64", "int64"},
+		{"F32", "float32"},
+		{"F64", "float64"},
+		{"Bools", "[]bool"},
+		{"I32s", "[]int32"},
+		{"I64s", "[]int64"},
+		{"F32s", "[]float32"},
+		{"F64s", "[]float64"},
+	}
+	retTypes = []typ{
+		{"Bool", "bool"},
+		{"F64", "float64"},
+		{"F64s", "[]float64"},
+	}
+)
+
+// MaxArgs is the maximum number of arguments of a signature.
+const MaxArgs = 4
+
+// Signature is a function signature.
+type Signature struct {
+	args []typ
+	ret  typ
+}
+
+// Default returns the signatures registered by default: all the
+// signatures with up to two arguments, and those with three or
+// four arguments of the same type, eg func(f, f, f, f float32) float64.
+func Default() []Signature {
+	lists := [][]typ{{}}
+	for _, t1 := range argTypes {
+		lists = append(lists, []typ{t1})
+	}
+	for _, t1 := range argTypes {
+		for _, t2 := range argTypes {
+			lists = append(lists, []typ{t1, t2})
+		}
+	}
+	for n := 3; n <= MaxArgs; n++ {
+		for _, t := range argTypes {
+			args := make([]typ, n)
+			for i := range args {
+				args[i] = t
+			}
+			lists = append(lists, args)
+		}
+	}
+	return signatures(lists)
+}
+
+// Others returns the signatures of All which are not in Default.
+func Others() []Signature {
+	dflt := make(map[string]bool)
+	for _, s := range Default() {
+		dflt[s.Def()] = true
+	}
+	var sigs []Signature
+	for _, s := range All() {
+		if !dflt[s.Def()] {
+			sigs = append(sigs, s)
+		}
+	}
+	return sigs
+}
+
+// All returns all the signatures, ie every combination of up
+// to MaxArgs argument types, for each returned type.
+func All() []Signature {
+	lists := [][]typ{{}}
+	for n, prev := 1, [][]typ{{}}; n <= MaxArgs; n++ {
+		var cur [][]typ
+		for _, args := range prev {
+			for _, t := range argTypes {
+				cur = append(cur, append(append([]typ{}, args...), t))
+			}
+		}
+		lists = append(lists, cur...)
+		prev = cur
+	}
+	return signatures(lists)
+}
+
+// Helper function returning the signatures of the lists
+// of arguments, for each returned type.
+func signatures(lists [][]typ) []Signature {
+	sigs := make([]Signature, 0, len(lists)*len(retTypes))
+	for _, args := range lists {
+		for _, ret := range retTypes {
+			sigs = append(sigs, Signature{args: args, ret: ret})
+		}
+	}
+	return sigs
+}
+
+// Def returns the Go definition of the signature.
+func (s Signature) Def() string {
+	args := make([]string, len(s.args))
+	for i, a := range s.args {
+		args[i] = a.Def
+	}
+	return fmt.Sprintf("func(%s) %s", strings.Join(args, ", "), s.ret.Def)
+}
+
+// Helper function returning the name of the binding function.
+func (s Signature) bindName() string {
+	name := "bind"
+	for _, a := range s.args {
+		name += a.Name
+	}
+	return name + "To" + s.ret.Name
+}
+
+// Helper function writing the binding function.
+func (s Signature) genBind(buf *bytes.Buffer) {
+	fmt.Fprintf(buf, "\nfunc %s(fct interface{}, ptrs []interface{}) interface{} {\n", s.bindName())
+	fmt.Fprintf(buf, "\tf := fct.(%s)\n", s.Def())
+	vals := make([]string, len(s.args))
+	for i, a := range s.args {
+		fmt.Fprintf(buf, "\tx%d := ptrs[%d].(*%s)\n", i+1, i, a.Def)
+		vals[i] = fmt.Sprintf("*x%d", i+1)
+	}
+	fmt.Fprintf(buf, "\treturn func() %s { return f(%s) }\n}\n", s.ret.Def, strings.Join(vals, ", "))
+}
+
+// Generate returns the formatted Go source of the package pkg,
+// registering the prototype and the binding function of each
+// signature in internal/fastfuncs, which is pkg itself or is
+// imported by it.
+func Generate(pkg string, sigs []Signature) ([]byte, error) {
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by gen-funcs; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package %s\n\n", pkg)
+
+	qual := ""
+	if pkg != "fastfuncs" {
+		fmt.Fprintf(buf, "import \"github.com/rmadar/tree-gonalyzer/internal/fastfuncs\"\n\n")
+		qual = "fastfuncs."
+	}
+
+	fmt.Fprintf(buf, "func init() {\n\t%sRegister([]%sFunc{\n", qual, qual)
+	for _, s := range sigs {
+		fmt.Fprintf(buf, "\t\t{Fct: (%s)(nil), Bind: %s},\n", s.Def(), s.bindName())
+	}
+	fmt.Fprintf(buf, "\t})\n}\n")
+
+	for _, s := range sigs {
+		s.genBind(buf)
+	}
+
+	return format.Source(buf.Bytes())
+}
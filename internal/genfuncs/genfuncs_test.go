@@ -0,0 +1,84 @@
+package genfuncs
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	sigs := All()
+
+	// Each combination of up to 4 argument types, for each
+	// returned type.
+	n := 0
+	for i, p := 0, 1; i <= MaxArgs; i, p = i+1, p*len(argTypes) {
+		n += p
+	}
+	if got, want := len(sigs), n*len(retTypes); got != want {
+		t.Fatalf("invalid number of signatures: got=%d, want=%d", got, want)
+	}
+	seen := make(map[string]bool, len(sigs))
+	for _, s := range sigs {
+		if seen[s.Def()] {
+			t.Fatalf("duplicate signature %s", s.Def())
+		}
+		seen[s.Def()] = true
+	}
+	for _, def := range []string{
+		"func() bool",
+		"func([]float32, int32, bool, []float64) []float64",
+		"func(int64, []bool, float32) float64",
+	} {
+		if !seen[def] {
+			t.Errorf("missing signature %s", def)
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	// All the signatures with up to 2 arguments, and with 3 or
+	// 4 arguments of the same type, for each returned type.
+	n := 1 + len(argTypes) + len(argTypes)*len(argTypes) + 2*len(argTypes)
+	if got, want := len(Default()), n*len(retTypes); got != want {
+		t.Fatalf("invalid number of default signatures: got=%d, want=%d", got, want)
+	}
+	if got, want := len(Default())+len(Others()), len(All()); got != want {
+		t.Fatalf("invalid number of other signatures: got=%d, want=%d", got, want)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	code, err := Generate("fastfuncs", All()[:100])
+	if err != nil {
+		t.Fatalf("could not generate code: %+v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "gen.go", code, 0); err != nil {
+		t.Fatalf("invalid generated code: %+v", err)
+	}
+	for _, s := range []string{
+		"package fastfuncs",
+		"Register([]Func{",
+		"{Fct: (func(bool, int32) float64)(nil), Bind: bindBoolI32ToF64},",
+		"func bindBoolI32ToF64(",
+	} {
+		if !strings.Contains(string(code), s) {
+			t.Errorf("generated code does not contain %q", s)
+		}
+	}
+
+	code, err = Generate("allfuncs", Others()[:100])
+	if err != nil {
+		t.Fatalf("could not generate code: %+v", err)
+	}
+	for _, s := range []string{
+		"package allfuncs",
+		`import "github.com/rmadar/tree-gonalyzer/internal/fastfuncs"`,
+		"fastfuncs.Register([]fastfuncs.Func{",
+	} {
+		if !strings.Contains(string(code), s) {
+			t.Errorf("generated code does not contain %q", s)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Package table writes the text tables of yields and cut flows,
+// as aligned text, Markdown or LaTeX.
+package table
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Table is a text table, made of groups of rows separated
+// by horizontal lines. The first NLeft columns (at least
+// one) are aligned on the left, the others on the right.
+type Table struct {
+	Header []string
+	NLeft  int
+	Groups [][]Row
+}
+
+// Row is a row of a table.
+type Row struct {
+	Cells []Cell
+	Bold  bool // Totals, highlighted when possible.
+}
+
+// Cell is a table cell, either a text or a value
+// with possibly symmetric or asymmetric uncertainties.
+type Cell struct {
+	kind   cellKind
+	txt    string
+	v      float64
+	lo, up float64 // Uncertainties, lo being used if symmetric.
+	prec   int
+}
+
+type cellKind int
+
+const (
+	cellText cellKind = iota
+	cellValue
+	cellSym
+	cellAsym
+)
+
+// Text returns a text cell.
+func Text(s string) Cell { return Cell{kind: cellText, txt: s} }
+
+// Value returns a cell of a value, with prec decimals.
+func Value(v float64, prec int) Cell { return Cell{kind: cellValue, v: v, prec: prec} }
+
+// Sym returns a cell of a value with a symmetric uncertainty.
+func Sym(v, err float64, prec int) Cell { return Cell{kind: cellSym, v: v, lo: err, prec: prec} }
+
+// Asym returns a cell of a value with asymmetric uncertainties.
+func Asym(v, lo, up float64, prec int) Cell {
+	return Cell{kind: cellAsym, v: v, lo: lo, up: up, prec: prec}
+}
+
+// text returns the cell as plain text: "v ± err" or "v +up/-lo".
+func (c Cell) text() string {
+	switch c.kind {
+	case cellValue:
+		return fmt.Sprintf("%.*f", c.prec, c.v)
+	case cellSym:
+		return fmt.Sprintf("%.*f ± %.*f", c.prec, c.v, c.prec, c.lo)
+	case cellAsym:
+		return fmt.Sprintf("%.*f +%.*f/-%.*f", c.prec, c.v, c.prec, c.up, c.prec, c.lo)
+	}
+	return c.txt
+}
+
+// latex returns the cell as LaTeX code, values being in math mode.
+func (c Cell) latex() string {
+	switch c.kind {
+	case cellValue:
+		return fmt.Sprintf("%.*f", c.prec, c.v)
+	case cellSym:
+		return fmt.Sprintf(`$%.*f \pm %.*f$`, c.prec, c.v, c.prec, c.lo)
+	case cellAsym:
+		return fmt.Sprintf(`$%.*f^{+%.*f}_{-%.*f}$`, c.prec, c.v, c.prec, c.up, c.prec, c.lo)
+	}
+	return latexEscape(c.txt)
+}
+
+// nLeft returns the number of columns aligned on the left.
+func (t Table) nLeft() int {
+	if t.NLeft < 1 {
+		return 1
+	}
+	return t.NLeft
+}
+
+// WriteASCII writes the table as aligned text.
+func (t Table) WriteASCII(w io.Writer) error {
+
+	lines := make([][][]string, len(t.Groups))
+	widths := make([]int, len(t.Header))
+	for i, h := range t.Header {
+		widths[i] = utf8.RuneCountInString(h)
+	}
+	for ig, g := range t.Groups {
+		lines[ig] = make([][]string, len(g))
+		for ir, r := range g {
+			lines[ig][ir] = make([]string, len(r.Cells))
+			for i, c := range r.Cells {
+				lines[ig][ir][i] = c.text()
+				if n := utf8.RuneCountInString(lines[ig][ir][i]); n > widths[i] {
+					widths[i] = n
+				}
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	writeLine := func(line []string) {
+		for i, s := range line {
+			pad := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(s))
+			if i < t.nLeft() {
+				fmt.Fprintf(bw, "| %s%s ", s, pad)
+			} else {
+				fmt.Fprintf(bw, "| %s%s ", pad, s)
+			}
+		}
+		fmt.Fprintf(bw, "|\n")
+	}
+	writeSep := func() {
+		for _, n := range widths {
+			fmt.Fprintf(bw, "|%s", strings.Repeat("-", n+2))
+		}
+		fmt.Fprintf(bw, "|\n")
+	}
+
+	fmt.Fprintf(bw, "\n")
+	writeLine(t.Header)
+	writeSep()
+	for ig, g := range lines {
+		if ig > 0 {
+			writeSep()
+		}
+		for _, l := range g {
+			writeLine(l)
+		}
+	}
+	fmt.Fprintf(bw, "\n")
+
+	return bw.Flush()
+}
+
+// WriteMarkdown writes the table in Markdown, rows in
+// bold being the totals.
+func (t Table) WriteMarkdown(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "| %s |\n|", strings.Join(t.Header, " | "))
+	fmt.Fprintf(bw, "%s", strings.Repeat(":---|", t.nLeft()))
+	fmt.Fprintf(bw, "%s\n", strings.Repeat("---:|", len(t.Header)-t.nLeft()))
+	for _, g := range t.Groups {
+		for _, r := range g {
+			cells := make([]string, len(r.Cells))
+			for i, c := range r.Cells {
+				cells[i] = c.text()
+			}
+			if r.Bold && cells[0] != "" {
+				cells[0] = "**" + cells[0] + "**"
+			}
+			fmt.Fprintf(bw, "| %s |\n", strings.Join(cells, " | "))
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteLaTeX writes the table as a LaTeX tabular.
+func (t Table) WriteLaTeX(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "\\begin{tabular}{%s|%s}\n\\hline\n",
+		strings.Repeat("l", t.nLeft()), strings.Repeat("r", len(t.Header)-t.nLeft()))
+	header := make([]string, len(t.Header))
+	for i, h := range t.Header {
+		header[i] = latexEscape(h)
+	}
+	fmt.Fprintf(bw, "%s \\\\\n\\hline\n", strings.Join(header, " & "))
+	for ig, g := range t.Groups {
+		if ig > 0 {
+			fmt.Fprintf(bw, "\\hline\n")
+		}
+		for _, r := range g {
+			cells := make([]string, len(r.Cells))
+			for i, c := range r.Cells {
+				cells[i] = c.latex()
+			}
+			fmt.Fprintf(bw, "%s \\\\\n", strings.Join(cells, " & "))
+		}
+	}
+	fmt.Fprintf(bw, "\\hline\n\\end{tabular}\n")
+	return bw.Flush()
+}
+
+// latexEscape escapes the LaTeX special characters.
+func latexEscape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\textbackslash{}`,
+		"_", `\_`, "&", `\&`, "%", `\%`, "#", `\#`,
+		"$", `\$`, "{", `\{`, "}", `\}`,
+		">", `$>$`, "<", `$<$`,
+	).Replace(s)
+}
@@ -0,0 +1,75 @@
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTable(t *testing.T) {
+
+	tab := Table{
+		Header: []string{"Sample", "pT > 10", "a_b"},
+		Groups: [][]Row{
+			{
+				{Cells: []Cell{Text("bkg1"), Sym(1.5, 0.25, 2), Value(3, 0)}},
+				{Cells: []Cell{Text("bkg2"), Asym(1, 0.1, 0.2, 1), Text("-")}},
+			},
+			{
+				{Cells: []Cell{Text("Total"), Sym(10, 1, 1), Value(3, 0)}, Bold: true},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name  string
+		write func(t Table, w *strings.Builder) error
+		want  string
+	}{
+		{
+			name:  "ascii",
+			write: func(t Table, w *strings.Builder) error { return t.WriteASCII(w) },
+			want: `
+| Sample |       pT > 10 | a_b |
+|--------|---------------|-----|
+| bkg1   |   1.50 ± 0.25 |   3 |
+| bkg2   | 1.0 +0.2/-0.1 |   - |
+|--------|---------------|-----|
+| Total  |    10.0 ± 1.0 |   3 |
+
+`,
+		},
+		{
+			name:  "markdown",
+			write: func(t Table, w *strings.Builder) error { return t.WriteMarkdown(w) },
+			want: `| Sample | pT > 10 | a_b |
+|:---|---:|---:|
+| bkg1 | 1.50 ± 0.25 | 3 |
+| bkg2 | 1.0 +0.2/-0.1 | - |
+| **Total** | 10.0 ± 1.0 | 3 |
+`,
+		},
+		{
+			name:  "latex",
+			write: func(t Table, w *strings.Builder) error { return t.WriteLaTeX(w) },
+			want: `\begin{tabular}{l|rr}
+\hline
+Sample & pT $>$ 10 & a\_b \\
+\hline
+bkg1 & $1.50 \pm 0.25$ & 3 \\
+bkg2 & $1.0^{+0.2}_{-0.1}$ & - \\
+\hline
+Total & $10.0 \pm 1.0$ & 3 \\
+\hline
+\end{tabular}
+`,
+		},
+	} {
+		var w strings.Builder
+		if err := tc.write(tab, &w); err != nil {
+			t.Fatalf("%s: %+v", tc.name, err)
+		}
+		if got := w.String(); got != tc.want {
+			t.Errorf("%s: got:\n%s\nwant:\n%s", tc.name, got, tc.want)
+		}
+	}
+}